@@ -0,0 +1,310 @@
+// Package axonhttp provides an http.Handler which exposes the wiring of an axon.Injector for debugging.
+package axonhttp
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/eddieowens/axon"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Handler creates an http.Handler which serves the following read-only routes for inj
+//
+//    GET /keys               every Key within inj as a JSON list of strings
+//    GET /keys/{key}         the Binding for a single Key as JSON. If several Keys print as the same string e.g.
+//                            NewKey(1) and NewKey("1"), a Conflict listing all of their Bindings is served with a 409
+//    GET /graph              the dependency Graph as JSON
+//    GET /graph?format=dot   the dependency Graph in the Graphviz DOT format
+//    GET /timings            how long each constructed value took to build as a JSON list of Timings, slowest first
+//
+// The routes are relative to the root of the Handler so use http.StripPrefix when mounting it elsewhere.
+//
+//    http.Handle("/debug/axon/", http.StripPrefix("/debug/axon", axonhttp.Handler(inj)))
+//
+// No values are ever served, only metadata about them.
+func Handler(inj axon.Injector) http.Handler {
+	return &handler{Injector: inj}
+}
+
+// Binding the JSON representation of an axon.BindingInfo.
+type Binding struct {
+	Key                    string            `json:"key"`
+	TypeKey                bool              `json:"typeKey"`
+	Type                   string            `json:"type,omitempty"`
+	Factory                bool              `json:"factory"`
+	Instantiated           bool              `json:"instantiated"`
+	Scope                  string            `json:"scope"`
+	ConstructionDuration   string            `json:"constructionDuration,omitempty"`
+	Dependencies           []string          `json:"dependencies"`
+	TransitiveDependencies []string          `json:"transitiveDependencies"`
+	Dependents             []string          `json:"dependents"`
+	TransitiveDependents   []string          `json:"transitiveDependents"`
+	Description            string            `json:"description,omitempty"`
+	Labels                 map[string]string `json:"labels,omitempty"`
+	Source                 string            `json:"source,omitempty"`
+	Secret                 bool              `json:"secret"`
+}
+
+// Conflict the JSON served when a requested key matches several Keys that print as the same string.
+type Conflict struct {
+	Error    string    `json:"error"`
+	Bindings []Binding `json:"bindings"`
+}
+
+// Graph the JSON representation of the dependency graph of an axon.Injector.
+type Graph struct {
+	Nodes []Node `json:"nodes"`
+	Edges []Edge `json:"edges"`
+}
+
+// Node a single Key within a Graph.
+type Node struct {
+	Key          string            `json:"key"`
+	Type         string            `json:"type,omitempty"`
+	Instantiated bool              `json:"instantiated"`
+	Description  string            `json:"description,omitempty"`
+	Labels       map[string]string `json:"labels,omitempty"`
+	Source       string            `json:"source,omitempty"`
+	Secret       bool              `json:"secret"`
+}
+
+// Edge signifies that the From Key depends on the To Key.
+type Edge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// Timing how long a single value took to construct.
+type Timing struct {
+	Key string `json:"key"`
+
+	// The construction duration in nanoseconds.
+	Duration time.Duration `json:"duration"`
+
+	// The human-readable form of Duration e.g. 1.5ms.
+	Human string `json:"human"`
+}
+
+type handler struct {
+	Injector axon.Injector
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+
+	p := strings.TrimSuffix(r.URL.Path, "/")
+	switch {
+	case p == "" || p == "/keys":
+		h.serveKeys(w)
+	case strings.HasPrefix(r.URL.Path, "/keys/"):
+		// the key is used as is since a trailing slash may be part of it.
+		h.serveKey(w, strings.TrimPrefix(r.URL.Path, "/keys/"))
+	case p == "/graph":
+		h.serveGraph(w, r.URL.Query().Get("format"))
+	case p == "/timings":
+		h.serveTimings(w)
+	default:
+		writeError(w, http.StatusNotFound, fmt.Errorf("%s not found", r.URL.Path))
+	}
+}
+
+func (h *handler) serveKeys(w http.ResponseWriter) {
+	out := make([]string, 0)
+	for _, v := range h.bindings() {
+		out = append(out, v.Key.String())
+	}
+	writeJSON(w, out)
+}
+
+func (h *handler) serveKey(w http.ResponseWriter, key string) {
+	matches := make([]Binding, 0)
+	for _, v := range h.bindings() {
+		if v.Key.String() == key {
+			matches = append(matches, toBinding(v))
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		writeError(w, http.StatusNotFound, fmt.Errorf("key %s: %w", key, axon.ErrNotFound))
+	case 1:
+		writeJSON(w, matches[0])
+	default:
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		_ = json.NewEncoder(w).Encode(Conflict{
+			Error:    fmt.Sprintf("key %s matches %d keys", key, len(matches)),
+			Bindings: matches,
+		})
+	}
+}
+
+func (h *handler) serveGraph(w http.ResponseWriter, format string) {
+	g := Graph{Nodes: make([]Node, 0), Edges: make([]Edge, 0)}
+	for _, v := range h.bindings() {
+		g.Nodes = append(g.Nodes, Node{
+			Key:          v.Key.String(),
+			Type:         typeString(v),
+			Instantiated: v.Instantiated,
+			Description:  v.Description,
+			Labels:       v.Labels,
+			Source:       v.Source,
+			Secret:       v.Secret,
+		})
+		for _, d := range sortedKeys(v.Dependencies) {
+			g.Edges = append(g.Edges, Edge{From: v.Key.String(), To: d})
+		}
+	}
+
+	switch format {
+	case "", "json":
+		writeJSON(w, g)
+	case "dot":
+		w.Header().Set("Content-Type", "text/vnd.graphviz; charset=utf-8")
+		_ = writeDOT(w, g)
+	default:
+		writeError(w, http.StatusBadRequest, fmt.Errorf("unknown format %s", format))
+	}
+}
+
+func (h *handler) serveTimings(w http.ResponseWriter) {
+	out := make([]Timing, 0)
+	for _, v := range h.bindings() {
+		if v.Instantiated && v.ConstructionDuration > 0 {
+			out = append(out, Timing{
+				Key:      v.Key.String(),
+				Duration: v.ConstructionDuration,
+				Human:    v.ConstructionDuration.String(),
+			})
+		}
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Duration > out[j].Duration
+	})
+	writeJSON(w, out)
+}
+
+// bindings returns the BindingInfo of every Key within the Injector sorted by Key. Injector.Range describes every Key
+// at once so the result is consistent even while the Injector is in use.
+func (h *handler) bindings() []axon.BindingInfo {
+	out := make([]axon.BindingInfo, 0)
+	h.Injector.Range(func(_ axon.Key, val axon.BindingInfo) bool {
+		out = append(out, val)
+		return true
+	})
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Key.String() < out[j].Key.String()
+	})
+	return out
+}
+
+func toBinding(v axon.BindingInfo) Binding {
+	b := Binding{
+		Key:                    v.Key.String(),
+		TypeKey:                v.Key.IsTypeKey(),
+		Type:                   typeString(v),
+		Factory:                v.IsFactory,
+		Instantiated:           v.Instantiated,
+		Scope:                  v.Scope.String(),
+		Dependencies:           sortedKeys(v.Dependencies),
+		TransitiveDependencies: sortedKeys(v.TransitiveDependencies),
+		Dependents:             sortedKeys(v.Dependents),
+		TransitiveDependents:   sortedKeys(v.TransitiveDependents),
+		Description:            v.Description,
+		Labels:                 v.Labels,
+		Source:                 v.Source,
+		Secret:                 v.Secret,
+	}
+
+	if v.ConstructionDuration > 0 {
+		b.ConstructionDuration = v.ConstructionDuration.String()
+	}
+
+	return b
+}
+
+func writeDOT(w io.Writer, g Graph) error {
+	var sb strings.Builder
+	sb.WriteString("digraph axon {\n")
+	for _, n := range g.Nodes {
+		lines := []string{n.Key}
+		if n.Type != "" {
+			lines = append(lines, n.Type)
+		}
+		if n.Description != "" {
+			lines = append(lines, n.Description)
+		}
+		labelKeys := make([]string, 0, len(n.Labels))
+		for k := range n.Labels {
+			labelKeys = append(labelKeys, k)
+		}
+		sort.Strings(labelKeys)
+		for _, k := range labelKeys {
+			lines = append(lines, k+"="+n.Labels[k])
+		}
+
+		for i, l := range lines {
+			lines[i] = dotEscape(l)
+		}
+
+		style := "dashed"
+		if n.Instantiated {
+			style = "solid"
+		}
+
+		sb.WriteString(fmt.Sprintf("  \"%s\" [label=\"%s\", style=%s", dotEscape(n.Key), strings.Join(lines, `\n`), style))
+		if n.Source != "" {
+			sb.WriteString(fmt.Sprintf(", tooltip=\"%s\"", dotEscape(n.Source)))
+		}
+		sb.WriteString("];\n")
+	}
+
+	for _, e := range g.Edges {
+		sb.WriteString(fmt.Sprintf("  \"%s\" -> \"%s\";\n", dotEscape(e.From), dotEscape(e.To)))
+	}
+	sb.WriteString("}\n")
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func dotEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+}
+
+func typeString(v axon.BindingInfo) string {
+	if v.Type == nil {
+		return ""
+	}
+	return v.Type.String()
+}
+
+func sortedKeys(keys []axon.Key) []string {
+	out := make([]string, len(keys))
+	for i, k := range keys {
+		out[i] = k.String()
+	}
+	sort.Strings(out)
+	return out
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
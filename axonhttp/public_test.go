@@ -0,0 +1,350 @@
+package axonhttp
+
+import (
+	"encoding/json"
+	"github.com/eddieowens/axon"
+	"github.com/stretchr/testify/suite"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+}
+
+type db struct{}
+
+type server struct {
+	DB *db `inject:"db"`
+}
+
+func (h *HandlerTestSuite) newServer() *httptest.Server {
+	inj := axon.NewInjector()
+	inj.Add(axon.NewKey("db"), new(db),
+		axon.WithDescription(`the "main" database`),
+		axon.WithLabels(map[string]string{"owner": "storage"}),
+		axon.WithSecret(),
+	)
+	inj.Add(axon.NewKey("server"), new(server))
+	inj.Add(axon.NewKey("unused"), axon.NewFactory[int](func(_ axon.Injector) (int, error) {
+		return 1, nil
+	}))
+	_, err := inj.Get(axon.NewKey("server"))
+	h.Require().NoError(err)
+
+	srv := httptest.NewServer(Handler(inj))
+	h.T().Cleanup(srv.Close)
+	return srv
+}
+
+func (h *HandlerTestSuite) get(srv *httptest.Server, path string) (*http.Response, string) {
+	resp, err := http.Get(srv.URL + path)
+	h.Require().NoError(err)
+	defer resp.Body.Close()
+
+	var sb strings.Builder
+	_, err = io.Copy(&sb, resp.Body)
+	h.Require().NoError(err)
+	return resp, sb.String()
+}
+
+func (h *HandlerTestSuite) TestKeys() {
+	// -- Given
+	//
+	srv := h.newServer()
+
+	// -- When
+	//
+	resp, body := h.get(srv, "/keys")
+
+	// -- Then
+	//
+	h.Equal(http.StatusOK, resp.StatusCode)
+	h.Equal("application/json", resp.Header.Get("Content-Type"))
+	h.JSONEq(`["db", "server", "unused"]`, body)
+}
+
+func (h *HandlerTestSuite) TestKey() {
+	// -- Given
+	//
+	srv := h.newServer()
+
+	// -- When
+	//
+	resp, body := h.get(srv, "/keys/db")
+
+	// -- Then
+	//
+	h.Equal(http.StatusOK, resp.StatusCode)
+	actual := Binding{}
+	h.Require().NoError(json.Unmarshal([]byte(body), &actual))
+	h.Equal("db", actual.Key)
+	h.False(actual.TypeKey)
+	h.Equal("*axonhttp.db", actual.Type)
+	h.False(actual.Factory)
+	h.True(actual.Instantiated)
+	h.Equal("Singleton", actual.Scope)
+	h.NotEmpty(actual.ConstructionDuration)
+	h.Empty(actual.Dependencies)
+	h.Equal([]string{"server"}, actual.Dependents)
+	h.Equal([]string{"server"}, actual.TransitiveDependents)
+	h.Equal(`the "main" database`, actual.Description)
+	h.Equal(map[string]string{"owner": "storage"}, actual.Labels)
+	h.True(actual.Secret)
+}
+
+func (h *HandlerTestSuite) TestKeyNotFound() {
+	// -- Given
+	//
+	srv := h.newServer()
+
+	// -- When
+	//
+	resp, body := h.get(srv, "/keys/nope")
+
+	// -- Then
+	//
+	h.Equal(http.StatusNotFound, resp.StatusCode)
+	h.JSONEq(`{"error": "key nope: not found"}`, body)
+}
+
+func (h *HandlerTestSuite) TestKeyConflict() {
+	// -- Given
+	//
+	inj := axon.NewInjector()
+	inj.Add(axon.NewKey(1), "int")
+	inj.Add(axon.NewKey("1"), 1)
+	srv := httptest.NewServer(Handler(inj))
+	h.T().Cleanup(srv.Close)
+
+	// -- When
+	//
+	resp, body := h.get(srv, "/keys/1")
+
+	// -- Then
+	//
+	h.Equal(http.StatusConflict, resp.StatusCode)
+	actual := Conflict{}
+	h.Require().NoError(json.Unmarshal([]byte(body), &actual))
+	h.Equal("key 1 matches 2 keys", actual.Error)
+	if h.Len(actual.Bindings, 2) {
+		h.ElementsMatch([]string{"string", "int"}, []string{actual.Bindings[0].Type, actual.Bindings[1].Type})
+	}
+}
+
+func (h *HandlerTestSuite) TestKeyTrailingSlash() {
+	// -- Given
+	//
+	inj := axon.NewInjector()
+	inj.Add(axon.NewKey("dir/"), 1)
+	srv := httptest.NewServer(Handler(inj))
+	h.T().Cleanup(srv.Close)
+
+	// -- When
+	//
+	resp, body := h.get(srv, "/keys/dir/")
+	missingResp, _ := h.get(srv, "/keys/dir")
+
+	// -- Then
+	//
+	h.Equal(http.StatusOK, resp.StatusCode)
+	actual := Binding{}
+	h.Require().NoError(json.Unmarshal([]byte(body), &actual))
+	h.Equal("dir/", actual.Key)
+	h.Equal(http.StatusNotFound, missingResp.StatusCode)
+}
+
+func (h *HandlerTestSuite) TestGraph() {
+	// -- Given
+	//
+	srv := h.newServer()
+
+	// -- When
+	//
+	resp, body := h.get(srv, "/graph")
+
+	// -- Then
+	//
+	h.Equal(http.StatusOK, resp.StatusCode)
+	h.JSONEq(`{
+		"nodes": [
+			{"key": "db", "type": "*axonhttp.db", "instantiated": true, "description": "the \"main\" database", "labels": {"owner": "storage"}, "secret": true},
+			{"key": "server", "type": "*axonhttp.server", "instantiated": true, "secret": false},
+			{"key": "unused", "type": "int", "instantiated": false, "secret": false}
+		],
+		"edges": [
+			{"from": "server", "to": "db"}
+		]
+	}`, body)
+}
+
+func (h *HandlerTestSuite) TestGraphConcurrentGet() {
+	// -- Given
+	//
+	inj := axon.NewInjector()
+	inj.Add(axon.NewKey("db"), new(db))
+	srv := httptest.NewServer(Handler(inj))
+	h.T().Cleanup(srv.Close)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 50; i++ {
+			inj.Add(axon.NewKey("server"), new(server))
+			_, _ = inj.Get(axon.NewKey("server"))
+		}
+	}()
+
+	// -- When
+	//
+	for i := 0; i < 10; i++ {
+		resp, _ := h.get(srv, "/graph")
+		h.Equal(http.StatusOK, resp.StatusCode)
+	}
+	<-done
+
+	// -- Then
+	//
+	_, body := h.get(srv, "/graph")
+	actual := Graph{}
+	h.Require().NoError(json.Unmarshal([]byte(body), &actual))
+	h.Equal([]Edge{{From: "server", To: "db"}}, actual.Edges)
+}
+
+func (h *HandlerTestSuite) TestGraphDOT() {
+	// -- Given
+	//
+	srv := h.newServer()
+
+	expected := `digraph axon {
+  "db" [label="db\n*axonhttp.db\nthe \"main\" database\nowner=storage", style=solid];
+  "server" [label="server\n*axonhttp.server", style=solid];
+  "unused" [label="unused\nint", style=dashed];
+  "server" -> "db";
+}
+`
+
+	// -- When
+	//
+	resp, body := h.get(srv, "/graph?format=dot")
+
+	// -- Then
+	//
+	h.Equal(http.StatusOK, resp.StatusCode)
+	h.Equal("text/vnd.graphviz; charset=utf-8", resp.Header.Get("Content-Type"))
+	h.Equal(expected, body)
+}
+
+func (h *HandlerTestSuite) TestGraphDOTSource() {
+	// -- Given
+	//
+	inj := axon.NewInjector()
+	inj.Add(axon.NewKey("a"), 1, axon.WithSource())
+
+	rec := httptest.NewRecorder()
+
+	// -- When
+	//
+	Handler(inj).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/graph?format=dot", nil))
+
+	// -- Then
+	//
+	h.Contains(rec.Body.String(), `tooltip="`)
+	h.Contains(rec.Body.String(), `public_test.go:`)
+}
+
+func (h *HandlerTestSuite) TestGraphUnknownFormat() {
+	// -- Given
+	//
+	srv := h.newServer()
+
+	// -- When
+	//
+	resp, body := h.get(srv, "/graph?format=svg")
+
+	// -- Then
+	//
+	h.Equal(http.StatusBadRequest, resp.StatusCode)
+	h.JSONEq(`{"error": "unknown format svg"}`, body)
+}
+
+func (h *HandlerTestSuite) TestTimings() {
+	// -- Given
+	//
+	srv := h.newServer()
+
+	// -- When
+	//
+	resp, body := h.get(srv, "/timings")
+
+	// -- Then
+	//
+	h.Equal(http.StatusOK, resp.StatusCode)
+	actual := make([]Timing, 0)
+	h.Require().NoError(json.Unmarshal([]byte(body), &actual))
+	if h.Len(actual, 2) {
+		h.Equal("server", actual[0].Key)
+		h.Equal("db", actual[1].Key)
+		h.GreaterOrEqual(actual[0].Duration, actual[1].Duration)
+		h.Equal(actual[0].Duration.String(), actual[0].Human)
+	}
+}
+
+func (h *HandlerTestSuite) TestNotFound() {
+	// -- Given
+	//
+	srv := h.newServer()
+
+	// -- When
+	//
+	resp, _ := h.get(srv, "/nope")
+
+	// -- Then
+	//
+	h.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func (h *HandlerTestSuite) TestMethodNotAllowed() {
+	// -- Given
+	//
+	srv := h.newServer()
+
+	// -- When
+	//
+	resp, err := http.Post(srv.URL+"/keys", "application/json", nil)
+
+	// -- Then
+	//
+	if h.NoError(err) {
+		defer resp.Body.Close()
+		h.Equal(http.StatusMethodNotAllowed, resp.StatusCode)
+		h.Equal("GET, HEAD", resp.Header.Get("Allow"))
+	}
+}
+
+func (h *HandlerTestSuite) TestStripPrefix() {
+	// -- Given
+	//
+	inj := axon.NewInjector()
+	inj.Add(axon.NewKey("a"), 1)
+
+	mux := http.NewServeMux()
+	mux.Handle("/debug/axon/", http.StripPrefix("/debug/axon", Handler(inj)))
+	rec := httptest.NewRecorder()
+
+	// -- When
+	//
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/axon/keys/", nil))
+
+	// -- Then
+	//
+	h.Equal(http.StatusOK, rec.Code)
+	h.JSONEq(`["a"]`, rec.Body.String())
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
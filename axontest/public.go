@@ -0,0 +1,37 @@
+// Package axontest provides helpers for using axon within tests.
+package axontest
+
+import (
+	"github.com/eddieowens/axon"
+	"testing"
+)
+
+// Override adds val to the axon.DefaultInjector under key for the duration of the test t. Once t and all of its subtests
+// complete, the axon.DefaultInjector is restored to the state it was in prior to calling Override which brings back the
+// original binding for key along with any of its dependents. A value added as is is never destroyed (see
+// axon.PreDestroy) so it's still usable once it's restored. A value built by a Factory is destroyed when it's
+// overridden, the same as with axon.Add, and is constructed again on the next call to Get once it's restored. Values
+// constructed while the override is in place are destroyed once it's restored.
+//
+// Override swaps the bindings of the shared axon.DefaultInjector so it must not be used from tests that call
+// t.Parallel or alongside any other test that uses the same axon.Injector concurrently.
+//
+//    func TestDeleteUser(t *testing.T) {
+//        axontest.Override(t, "db", new(fakeDBClient))
+//        ...
+//    }
+func Override[K axon.InjectableKey](t testing.TB, key K, val any) {
+	t.Helper()
+	InjectorOverride(t, axon.DefaultInjector, key, val)
+}
+
+// InjectorOverride same as Override but for a specific axon.Injector.
+func InjectorOverride[K axon.InjectableKey](t testing.TB, inj axon.Injector, key K, val any) {
+	t.Helper()
+	s := inj.Snapshot()
+	t.Cleanup(func() {
+		inj.Restore(s)
+	})
+
+	axon.InjectAdd(inj, key, val)
+}
@@ -0,0 +1,131 @@
+package axontest
+
+import (
+	"github.com/eddieowens/axon"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type PublicTestSuite struct {
+	suite.Suite
+}
+
+func (p *PublicTestSuite) SetupTest() {
+	axon.DefaultInjector = axon.NewInjector()
+}
+
+func (p *PublicTestSuite) TestOverride() {
+	// -- Given
+	//
+	type service struct {
+		Name string `inject:"name"`
+	}
+
+	axon.Add("name", "original")
+	axon.Add("service", axon.NewFactory[*service](func(inj axon.Injector) (*service, error) {
+		name, err := axon.InjectorGet[string](inj, axon.WithKey("name"))
+		return &service{Name: name}, err
+	}))
+	_ = axon.MustGet[*service](axon.WithKey("service"))
+
+	// -- When
+	//
+	p.Run("overridden", func() {
+		Override(p.T(), "name", "override")
+		p.Equal("override", axon.MustGet[string](axon.WithKey("name")))
+	})
+
+	// -- Then
+	//
+	p.Equal("original", axon.MustGet[string](axon.WithKey("name")))
+	p.Equal("original", axon.MustGet[*service](axon.WithKey("service")).Name)
+}
+
+type db struct {
+	Closed bool
+}
+
+func (d *db) Destroy() error {
+	d.Closed = true
+	return nil
+}
+
+func (p *PublicTestSuite) TestOverridePreDestroy() {
+	// -- Given
+	//
+	original := new(db)
+	axon.Add("db", original)
+	_ = axon.MustGet[*db](axon.WithKey("db"))
+
+	// -- When
+	//
+	p.Run("overridden", func() {
+		Override(p.T(), "db", new(db))
+		p.NotSame(original, axon.MustGet[*db](axon.WithKey("db")))
+	})
+
+	// -- Then
+	//
+	p.Same(original, axon.MustGet[*db](axon.WithKey("db")))
+	p.False(original.Closed)
+}
+
+func (p *PublicTestSuite) TestOverrideFactoryPreDestroy() {
+	// -- Given
+	//
+	axon.Add("db", axon.NewFactory[*db](func(_ axon.Injector) (*db, error) {
+		return new(db), nil
+	}))
+	original := axon.MustGet[*db](axon.WithKey("db"))
+
+	// -- When
+	//
+	p.Run("overridden", func() {
+		Override(p.T(), "db", new(db))
+		p.NotSame(original, axon.MustGet[*db](axon.WithKey("db")))
+	})
+
+	// -- Then
+	//
+	restored := axon.MustGet[*db](axon.WithKey("db"))
+	p.True(original.Closed)
+	p.NotSame(original, restored)
+	p.False(restored.Closed)
+}
+
+func (p *PublicTestSuite) TestOverrideNewKey() {
+	// -- When
+	//
+	p.Run("overridden", func() {
+		Override(p.T(), "new", 1)
+		p.Equal(1, axon.MustGet[int](axon.WithKey("new")))
+	})
+
+	// -- Then
+	//
+	_, err := axon.Get[int](axon.WithKey("new"))
+	p.ErrorIs(err, axon.ErrNotFound)
+}
+
+func (p *PublicTestSuite) TestInjectorOverrideProvider() {
+	// -- Given
+	//
+	inj := axon.NewInjector()
+	inj.Add(axon.NewKey("secret"), axon.NewProvider("original"))
+	secret, _ := axon.InjectorGet[*axon.Provider[string]](inj, axon.WithKey("secret"))
+
+	// -- When
+	//
+	p.Run("overridden", func() {
+		InjectorOverride(p.T(), inj, "secret", axon.NewProvider("override"))
+		p.Equal("override", secret.Get())
+	})
+
+	// -- Then
+	//
+	p.Equal("original", secret.Get())
+}
+
+func TestPublicTestSuite(t *testing.T) {
+	suite.Run(t, new(PublicTestSuite))
+}
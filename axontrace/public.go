@@ -0,0 +1,166 @@
+// Package axontrace provides an in-memory axon.Tracer which records how long each value within an axon.Injector takes to
+// construct.
+package axontrace
+
+import (
+	"fmt"
+	"github.com/eddieowens/axon"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// BarWidth the width of the bar drawn by InMemoryExporter.WriteTree for the slowest root Span.
+var BarWidth = 40
+
+// NewInMemoryExporter creates an InMemoryExporter. Use it with axon.WithTracer.
+//
+//    exporter := axontrace.NewInMemoryExporter()
+//    inj := axon.NewInjector(axon.WithTracer(exporter))
+//    ...
+//    _ = exporter.WriteTree(os.Stdout)
+func NewInMemoryExporter() *InMemoryExporter {
+	return &InMemoryExporter{
+		now: time.Now,
+	}
+}
+
+// InMemoryExporter an axon.Tracer that keeps every Span in memory.
+type InMemoryExporter struct {
+	roots []*Span
+	lock  sync.Mutex
+	now   func() time.Time
+}
+
+// Span a single construction recorded by the InMemoryExporter.
+type Span struct {
+	// The Key of the value that was constructed.
+	Key axon.Key
+
+	// When the construction started.
+	Start time.Time
+
+	// How long the construction took including the construction of all Children.
+	Duration time.Duration
+
+	// The error the construction failed with, if any.
+	Err error
+
+	// The Spans of the values that were constructed as part of constructing this value.
+	Children []*Span
+
+	exporter *InMemoryExporter
+}
+
+func (s *Span) End(err error) {
+	s.exporter.lock.Lock()
+	defer s.exporter.lock.Unlock()
+	s.Duration = s.exporter.now().Sub(s.Start)
+	s.Err = err
+}
+
+func (e *InMemoryExporter) Start(parent axon.Span, key axon.Key) axon.Span {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	s := &Span{
+		Key:      key,
+		Start:    e.now(),
+		exporter: e,
+	}
+
+	if p, ok := parent.(*Span); ok {
+		p.Children = append(p.Children, s)
+	} else {
+		e.roots = append(e.roots, s)
+	}
+
+	return s
+}
+
+// Spans returns all the root Spans recorded in the order they were started.
+func (e *InMemoryExporter) Spans() []*Span {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	out := make([]*Span, len(e.roots))
+	copy(out, e.roots)
+	return out
+}
+
+// Reset drops all the recorded Spans.
+func (e *InMemoryExporter) Reset() {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.roots = nil
+}
+
+// WriteTree writes a flame-style tree of all the recorded Spans to w. Every Span is written on its own line, indented
+// under its parent, along with its duration and a bar relative to the slowest root Span e.g.
+//
+//    server  12ms ████████████████████████████████████████
+//      db    10ms █████████████████████████████████
+//        cfg  1ms ███
+//      log    1ms ███
+func (e *InMemoryExporter) WriteTree(w io.Writer) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	lines := make([]treeLine, 0)
+	var longest time.Duration
+	for _, r := range e.roots {
+		lines = appendLines(lines, r, 0)
+		if r.Duration > longest {
+			longest = r.Duration
+		}
+	}
+
+	labelWidth := 0
+	durationWidth := 0
+	for _, l := range lines {
+		if len(l.Label) > labelWidth {
+			labelWidth = len(l.Label)
+		}
+		if len(l.Duration) > durationWidth {
+			durationWidth = len(l.Duration)
+		}
+	}
+
+	for _, l := range lines {
+		bar := 0
+		if longest > 0 {
+			bar = int(int64(BarWidth) * int64(l.Span.Duration) / int64(longest))
+		}
+
+		line := fmt.Sprintf("%-*s %*s %s", labelWidth, l.Label, durationWidth, l.Duration, strings.Repeat("█", bar))
+		if l.Span.Err != nil {
+			line += fmt.Sprintf(" error: %s", l.Span.Err.Error())
+		}
+
+		_, err := fmt.Fprintln(w, strings.TrimRight(line, " "))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type treeLine struct {
+	Label    string
+	Duration string
+	Span     *Span
+}
+
+func appendLines(lines []treeLine, s *Span, depth int) []treeLine {
+	lines = append(lines, treeLine{
+		Label:    strings.Repeat("  ", depth) + s.Key.String(),
+		Duration: s.Duration.String(),
+		Span:     s,
+	})
+
+	for _, c := range s.Children {
+		lines = appendLines(lines, c, depth+1)
+	}
+	return lines
+}
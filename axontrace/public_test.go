@@ -0,0 +1,128 @@
+package axontrace
+
+import (
+	"bytes"
+	"errors"
+	"github.com/eddieowens/axon"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type InMemoryExporterTestSuite struct {
+	suite.Suite
+}
+
+func (i *InMemoryExporterTestSuite) TestWriteTree() {
+	// -- Given
+	//
+	type server struct {
+		DB  string `inject:"db"`
+		Log string `inject:"log"`
+	}
+
+	exporter := newTestExporter()
+	inj := axon.NewInjector(axon.WithTracer(exporter))
+	inj.Add(axon.NewKey("cfg"), "cfg")
+	inj.Add(axon.NewKey("log"), "log")
+	inj.Add(axon.NewKey("db"), axon.NewFactory[string](func(inj axon.Injector) (string, error) {
+		return axon.InjectorGet[string](inj, axon.WithKey("cfg"))
+	}))
+	inj.Add(axon.NewKey("server"), new(server))
+	inj.Add(axon.NewKey("broken"), axon.NewFactory[string](func(_ axon.Injector) (string, error) {
+		return "", errors.New("error")
+	}))
+	_, _ = inj.Get(axon.NewKey("server"))
+	_, _ = inj.Get(axon.NewKey("broken"))
+
+	buf := new(bytes.Buffer)
+
+	// -- When
+	//
+	err := exporter.WriteTree(buf)
+
+	// -- Then
+	//
+	if i.NoError(err) {
+		i.Equal(""+
+			"server  7ms ████████████████████████████████████████\n"+
+			"  db    3ms █████████████████\n"+
+			"    cfg 1ms █████\n"+
+			"  log   1ms █████\n"+
+			"broken  1ms █████ error: error\n",
+			buf.String())
+	}
+}
+
+func (i *InMemoryExporterTestSuite) TestSpans() {
+	// -- Given
+	//
+	exporter := newTestExporter()
+	inj := axon.NewInjector(axon.WithTracer(exporter))
+	inj.Add(axon.NewKey("key"), "val")
+	_, _ = inj.Get(axon.NewKey("key"))
+
+	// -- When
+	//
+	actual := exporter.Spans()
+	exporter.Reset()
+
+	// -- Then
+	//
+	if i.Len(actual, 1) {
+		i.Equal(axon.NewKey("key"), actual[0].Key)
+		i.Equal(time.Millisecond, actual[0].Duration)
+		i.Empty(actual[0].Children)
+	}
+	i.Empty(exporter.Spans())
+}
+
+func (i *InMemoryExporterTestSuite) TestWriteTreeEmpty() {
+	// -- Given
+	//
+	buf := new(bytes.Buffer)
+
+	// -- When
+	//
+	err := NewInMemoryExporter().WriteTree(buf)
+
+	// -- Then
+	//
+	i.NoError(err)
+	i.Empty(buf.String())
+}
+
+func (i *InMemoryExporterTestSuite) TestWriteTreeErr() {
+	// -- Given
+	//
+	exporter := newTestExporter()
+	exporter.Start(nil, axon.NewKey("key")).End(nil)
+
+	// -- When
+	//
+	err := exporter.WriteTree(errWriter{})
+
+	// -- Then
+	//
+	i.EqualError(err, "write")
+}
+
+func newTestExporter() *InMemoryExporter {
+	e := NewInMemoryExporter()
+	now := time.Time{}
+	e.now = func() time.Time {
+		now = now.Add(time.Millisecond)
+		return now
+	}
+	return e
+}
+
+type errWriter struct{}
+
+func (e errWriter) Write(_ []byte) (int, error) {
+	return 0, errors.New("write")
+}
+
+func TestInMemoryExporterTestSuite(t *testing.T) {
+	suite.Run(t, new(InMemoryExporterTestSuite))
+}
@@ -9,6 +9,13 @@ type container[T any] interface {
 	GetReflectValue() reflect.Value
 	// GetExternalDependencies returns Keys that may be required that aren't explicitly listed on the container's value e.g. dependencies grabbed in a Factory.
 	GetExternalDependencies() []Key
+
+	// Destroy calls PreDestroy.Destroy on the container's value if it is implemented. PreDestroy.Destroy is only ever
+	// called once per container.
+	Destroy() error
+
+	// IsDestroyed returns true if the container's value has been destroyed and can no longer be used, false otherwise.
+	IsDestroyed() bool
 }
 
 func newContainer[T any](v T, externalDeps ...Key) container[T] {
@@ -22,6 +29,21 @@ type containerImpl[T any] struct {
 	Value                T
 	ReflectValue         *reflect.Value
 	ExternalDependencies []Key
+	Destroyed            bool
+}
+
+func (c *containerImpl[T]) Destroy() error {
+	d, ok := any(c.Value).(PreDestroy)
+	if !ok || c.Destroyed {
+		return nil
+	}
+
+	c.Destroyed = true
+	return d.Destroy()
+}
+
+func (c *containerImpl[T]) IsDestroyed() bool {
+	return c.Destroyed
 }
 
 func (c *containerImpl[T]) GetExternalDependencies() []Key {
@@ -0,0 +1,151 @@
+package axon
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ConverterFunc converts a value of type From into a value of type To. ConverterFuncs are used by the Injector whenever a
+// value stored within the Injector can't be directly assigned to the field it's being injected into.
+type ConverterFunc[From, To any] func(from From) (To, error)
+
+// RegisterConverter registers a ConverterFunc that is used whenever a value of type From needs to be injected into a field
+// of type To. Registering a ConverterFunc for a pair of types that already has one overwrites the existing ConverterFunc.
+//
+// Out of the box, strings can be converted to any numeric type, time.Duration, url.URL, and *url.URL. Integers are
+// always parsed as base 10 so "010" is 10. A panic within a ConverterFunc is returned as a ConstructionPanicError.
+//
+//    type Port int
+//    RegisterConverter(func(from string) (Port, error) {
+//        p, err := strconv.Atoi(from)
+//        return Port(p), err
+//    })
+func RegisterConverter[From, To any](f ConverterFunc[From, To]) {
+	converters.Add(reflect.TypeOf(new(From)).Elem(), reflect.TypeOf(new(To)).Elem(), func(src reflect.Value) (reflect.Value, error) {
+		from, _ := src.Interface().(From)
+		to, err := f(from)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(&to).Elem(), nil
+	})
+}
+
+var errNoConverter = errors.New("no converter")
+
+var stringType = reflect.TypeOf("")
+
+var converters = newConverterRegistry()
+
+type converter func(src reflect.Value) (reflect.Value, error)
+
+type converterKey struct {
+	From reflect.Type
+	To   reflect.Type
+}
+
+type converterRegistry struct {
+	Converters map[converterKey]converter
+	lock       sync.RWMutex
+}
+
+func newConverterRegistry() *converterRegistry {
+	r := &converterRegistry{
+		Converters: map[converterKey]converter{},
+	}
+
+	r.Add(stringType, reflect.TypeOf(time.Duration(0)), func(src reflect.Value) (reflect.Value, error) {
+		d, err := time.ParseDuration(src.String())
+		return reflect.ValueOf(d), err
+	})
+	r.Add(stringType, reflect.TypeOf(url.URL{}), func(src reflect.Value) (reflect.Value, error) {
+		u, err := url.Parse(src.String())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(*u), nil
+	})
+	r.Add(stringType, reflect.TypeOf(&url.URL{}), func(src reflect.Value) (reflect.Value, error) {
+		u, err := url.Parse(src.String())
+		return reflect.ValueOf(u), err
+	})
+
+	return r
+}
+
+func (c *converterRegistry) Add(from, to reflect.Type, conv converter) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.Converters[converterKey{From: from, To: to}] = conv
+}
+
+func (c *converterRegistry) Get(from, to reflect.Type) converter {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.Converters[converterKey{From: from, To: to}]
+}
+
+// Convert converts src into a value assignable to the dst type. Registered converters for the exact pair of types are
+// checked first followed by the built-in string converters. If no converter exists, errNoConverter is returned.
+func (c *converterRegistry) Convert(src reflect.Value, dst reflect.Type) (reflect.Value, error) {
+	if !src.IsValid() {
+		return reflect.Value{}, errNoConverter
+	}
+
+	conv := c.Get(src.Type(), dst)
+	if conv == nil && src.Kind() == reflect.String {
+		src = reflect.ValueOf(src.String())
+		conv = c.Get(stringType, dst)
+		if conv == nil {
+			conv = numberConverter(dst)
+		}
+	}
+
+	if conv == nil {
+		return reflect.Value{}, errNoConverter
+	}
+
+	return conv(src)
+}
+
+// numberConverter returns a converter that parses a string into the numeric type typ. If typ is not numeric, nil is
+// returned.
+func numberConverter(typ reflect.Type) converter {
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(src reflect.Value) (reflect.Value, error) {
+			i, err := strconv.ParseInt(src.String(), 10, typ.Bits())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			out := reflect.New(typ).Elem()
+			out.SetInt(i)
+			return out, nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(src reflect.Value) (reflect.Value, error) {
+			u, err := strconv.ParseUint(src.String(), 10, typ.Bits())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			out := reflect.New(typ).Elem()
+			out.SetUint(u)
+			return out, nil
+		}
+	case reflect.Float32, reflect.Float64:
+		return func(src reflect.Value) (reflect.Value, error) {
+			f, err := strconv.ParseFloat(src.String(), typ.Bits())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			out := reflect.New(typ).Elem()
+			out.SetFloat(f)
+			return out, nil
+		}
+	}
+	return nil
+}
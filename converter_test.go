@@ -0,0 +1,381 @@
+package axon
+
+import (
+	"errors"
+	"github.com/stretchr/testify/suite"
+	"net/url"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type ConverterTestSuite struct {
+	suite.Suite
+	registered map[converterKey]converter
+}
+
+func (c *ConverterTestSuite) SetupTest() {
+	converters.lock.RLock()
+	defer converters.lock.RUnlock()
+	c.registered = make(map[converterKey]converter, len(converters.Converters))
+	for k, v := range converters.Converters {
+		c.registered[k] = v
+	}
+}
+
+func (c *ConverterTestSuite) TearDownTest() {
+	converters.lock.Lock()
+	defer converters.lock.Unlock()
+	converters.Converters = c.registered
+}
+
+func (c *ConverterTestSuite) TestNamedTypeSameKind() {
+	// -- Given
+	//
+	type port int
+	type test struct {
+		Port port `inject:"port"`
+	}
+
+	inj := NewInjector()
+	inj.Add(NewKey("port"), 8080)
+
+	// -- When
+	//
+	err := inj.Inject(new(test))
+
+	// -- Then
+	//
+	c.ErrorIs(err, ErrInvalidType)
+	c.EqualError(err, "invalid type: field port is type axon.port but got type int")
+}
+
+func (c *ConverterTestSuite) TestStringToNumbers() {
+	// -- Given
+	//
+	type port int
+	type test struct {
+		Port  port    `inject:"port"`
+		Int8  int8    `inject:"int8"`
+		Uint  uint    `inject:"uint"`
+		Float float32 `inject:"float"`
+	}
+
+	inj := NewInjector()
+	inj.Add(NewKey("port"), "8080")
+	inj.Add(NewKey("int8"), "-012")
+	inj.Add(NewKey("uint"), "010")
+	inj.Add(NewKey("float"), "1.5")
+
+	expected := &test{Port: 8080, Int8: -12, Uint: 10, Float: 1.5}
+	actual := new(test)
+
+	// -- When
+	//
+	err := inj.Inject(actual)
+
+	// -- Then
+	//
+	if c.NoError(err) {
+		c.Equal(expected, actual)
+	}
+}
+
+func (c *ConverterTestSuite) TestStringToNumberFailures() {
+	type ints struct {
+		I int8 `inject:"i"`
+	}
+	type uints struct {
+		I uint8 `inject:"i"`
+	}
+	type floats struct {
+		I float32 `inject:"i"`
+	}
+
+	for _, v := range []any{new(ints), new(uints), new(floats)} {
+		// -- Given
+		//
+		inj := NewInjector()
+		inj.Add(NewKey("i"), "nope")
+
+		// -- When
+		//
+		err := inj.Inject(v)
+
+		// -- Then
+		//
+		c.ErrorIs(err, ErrInvalidType)
+	}
+}
+
+func (c *ConverterTestSuite) TestStringBasePrefix() {
+	// -- Given
+	//
+	type test struct {
+		I int `inject:"i"`
+	}
+
+	inj := NewInjector()
+	inj.Add(NewKey("i"), "0x10")
+
+	// -- When
+	//
+	err := inj.Inject(new(test))
+
+	// -- Then
+	//
+	c.ErrorIs(err, ErrInvalidType)
+}
+
+func (c *ConverterTestSuite) TestStringOverflow() {
+	// -- Given
+	//
+	type test struct {
+		I int8 `inject:"i"`
+	}
+
+	inj := NewInjector()
+	inj.Add(NewKey("i"), "1000")
+
+	// -- When
+	//
+	err := inj.Inject(new(test))
+
+	// -- Then
+	//
+	c.EqualError(err, `invalid type: failed to convert field i from type string to type int8: strconv.ParseInt: parsing "1000": value out of range`)
+}
+
+func (c *ConverterTestSuite) TestStringToDurationAndURL() {
+	// -- Given
+	//
+	type env string
+	type test struct {
+		Timeout time.Duration `inject:"timeout"`
+		URL     url.URL       `inject:"url"`
+		PtrURL  *url.URL      `inject:"url"`
+	}
+
+	inj := NewInjector()
+	inj.Add(NewKey("timeout"), env("1m"))
+	inj.Add(NewKey("url"), "https://example.com/path")
+
+	actual := new(test)
+
+	// -- When
+	//
+	err := inj.Inject(actual)
+
+	// -- Then
+	//
+	if c.NoError(err) {
+		c.Equal(time.Minute, actual.Timeout)
+		c.Equal("example.com", actual.URL.Host)
+		c.Equal("/path", actual.PtrURL.Path)
+	}
+}
+
+func (c *ConverterTestSuite) TestInvalidURL() {
+	// -- Given
+	//
+	type test struct {
+		URL    url.URL  `inject:"url"`
+		PtrURL *url.URL `inject:"url"`
+	}
+
+	inj := NewInjector()
+	inj.Add(NewKey("url"), "http://[::1")
+
+	// -- When
+	//
+	err := inj.Inject(new(test), WithSkipFieldErrs())
+	errVal := inj.Inject(new(test))
+
+	// -- Then
+	//
+	c.NoError(err)
+	c.ErrorIs(errVal, ErrInvalidType)
+}
+
+func (c *ConverterTestSuite) TestRegisterConverter() {
+	// -- Given
+	//
+	type celsius float64
+	type fahrenheit float64
+	type test struct {
+		F fahrenheit `inject:"temp"`
+	}
+
+	RegisterConverter(func(from celsius) (fahrenheit, error) {
+		return fahrenheit(from*9/5 + 32), nil
+	})
+
+	inj := NewInjector()
+	inj.Add(NewKey("temp"), celsius(100))
+	actual := new(test)
+
+	// -- When
+	//
+	err := inj.Inject(actual)
+
+	// -- Then
+	//
+	if c.NoError(err) {
+		c.Equal(fahrenheit(212), actual.F)
+	}
+}
+
+func (c *ConverterTestSuite) TestRegisterConverterNamedString() {
+	// -- Given
+	//
+	type test struct {
+		I int `inject:"i"`
+	}
+
+	type hex string
+	RegisterConverter(func(from hex) (int, error) {
+		i, err := strconv.ParseInt(string(from), 16, 64)
+		return int(i), err
+	})
+
+	inj := NewInjector()
+	inj.Add(NewKey("i"), hex("ff"))
+	actual := new(test)
+
+	// -- When
+	//
+	err := inj.Inject(actual)
+
+	// -- Then
+	//
+	if c.NoError(err) {
+		c.Equal(255, actual.I)
+	}
+}
+
+func (c *ConverterTestSuite) TestRegisterConverterError() {
+	// -- Given
+	//
+	type secret string
+	type test struct {
+		S secret `inject:"s"`
+	}
+
+	RegisterConverter(func(from int) (secret, error) {
+		return "", errors.New("not allowed")
+	})
+
+	inj := NewInjector()
+	inj.Add(NewKey("s"), 1)
+
+	// -- When
+	//
+	err := inj.Inject(new(test))
+
+	// -- Then
+	//
+	c.ErrorIs(err, ErrInvalidType)
+	c.EqualError(err, "invalid type: failed to convert field s from type int to type axon.secret: not allowed")
+}
+
+func (c *ConverterTestSuite) TestRegisterConverterPanic() {
+	// -- Given
+	//
+	type celsius float64
+	type test struct {
+		C celsius `inject:"c"`
+	}
+
+	RegisterConverter(func(from int) (celsius, error) {
+		panic("boom")
+	})
+
+	inj := NewInjector()
+	inj.Add(NewKey("c"), 1)
+
+	// -- When
+	//
+	err := inj.Inject(new(test))
+
+	// -- Then
+	//
+	c.ErrorIs(err, ErrInvalidType)
+	var panicErr *ConstructionPanicError
+	if c.ErrorAs(err, &panicErr) {
+		c.Equal("boom", panicErr.Value)
+		c.Equal(NewKey("c"), panicErr.Key)
+		c.NotEmpty(panicErr.Stack)
+	}
+}
+
+func (c *ConverterTestSuite) TestNilValue() {
+	// -- Given
+	//
+	type test struct {
+		P *testDep `inject:"p"`
+	}
+
+	inj := NewInjector()
+	inj.Add(NewKey("p"), nil)
+
+	// -- When
+	//
+	err := inj.Inject(new(test))
+
+	// -- Then
+	//
+	c.EqualError(err, "invalid type: field p is type *axon.testDep but got type nil")
+}
+
+func (c *ConverterTestSuite) TestNilMutableValue() {
+	// -- Given
+	//
+	type test struct {
+		M MutableValue `inject:"m"`
+	}
+
+	inj := NewInjector()
+	inj.Add(NewKey("m"), nil)
+
+	// -- When
+	//
+	err := inj.Inject(new(test))
+
+	// -- Then
+	//
+	c.EqualError(err, "invalid type: field m is type axon.MutableValue but got type nil")
+}
+
+func (c *ConverterTestSuite) TestStringNoConverter() {
+	// -- Given
+	//
+	type test struct {
+		B bool `inject:"b"`
+	}
+
+	inj := NewInjector()
+	inj.Add(NewKey("b"), "true")
+
+	// -- When
+	//
+	err := inj.Inject(new(test))
+
+	// -- Then
+	//
+	c.EqualError(err, "invalid type: field b is type bool but got type string")
+}
+
+func (c *ConverterTestSuite) TestConvertNoConverter() {
+	// -- When
+	//
+	_, err := converters.Convert(reflect.ValueOf(1), reflect.TypeOf(""))
+
+	// -- Then
+	//
+	c.ErrorIs(err, errNoConverter)
+}
+
+func TestConverterTestSuite(t *testing.T) {
+	suite.Run(t, new(ConverterTestSuite))
+}
@@ -0,0 +1,33 @@
+package axon
+
+// Decorator wraps values constructed by the Injector e.g. to add logging, metrics, or caching around a value without
+// changing where the value was added. Any Injector.Get method calls within the Decorate method will be registered as
+// dependencies of the decorated value.
+type Decorator interface {
+	// Decorate returns the value that replaces val within the Injector. If the Decorator does not apply to val, val
+	// should be returned as is.
+	Decorate(val any, inj Injector) (any, error)
+}
+
+// DecoratorFunc decorates all values within the Injector that are of type T. Values that are not of type T are left
+// untouched.
+type DecoratorFunc[T any] func(val T, inj Injector) (T, error)
+
+func (d DecoratorFunc[T]) Decorate(val any, inj Injector) (any, error) {
+	v, ok := val.(T)
+	if !ok {
+		return val, nil
+	}
+	return d(v, inj)
+}
+
+// Decorate adds a DecoratorFunc to the inj which is applied to every value of type T after it's constructed. If T is an
+// interface, every value implementing T is decorated. Decorators stack in the order they are added, so the first
+// Decorator added is the first to wrap the value.
+//
+//    axon.Decorate(inj, func(repo Repository, inj axon.Injector) (Repository, error) {
+//        return &loggingRepository{Repository: repo}, nil
+//    })
+func Decorate[T any](inj Injector, f DecoratorFunc[T]) {
+	inj.AddDecorator(f)
+}
@@ -0,0 +1,159 @@
+package axon
+
+import (
+	"errors"
+	"github.com/eddieowens/axon/internal/depgraph"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type DecoratorTestSuite struct {
+	suite.Suite
+}
+
+func (d *DecoratorTestSuite) TestDecorateInterface() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("ptr"), &testInterfacePtr{Int: 1})
+	inj.Add(NewKey("val"), testInterfaceVal{Int: 2})
+	inj.Add(NewKey("int"), 3)
+
+	// -- When
+	//
+	Decorate(inj, func(val testInterface, _ Injector) (testInterface, error) {
+		return &decoratedInterface{testInterface: val}, nil
+	})
+
+	// -- Then
+	//
+	ptr, _ := inj.Get(NewKey("ptr"))
+	val, _ := inj.Get(NewKey("val"))
+	i, _ := inj.Get(NewKey("int"))
+	d.Equal(&decoratedInterface{testInterface: &testInterfacePtr{Int: 1}}, ptr)
+	d.Equal(&decoratedInterface{testInterface: testInterfaceVal{Int: 2}}, val)
+	d.Equal(3, i)
+}
+
+func (d *DecoratorTestSuite) TestDecoratorsStack() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("s"), NewFactory[string](func(_ Injector) (string, error) {
+		return "s", nil
+	}))
+
+	// -- When
+	//
+	Decorate(inj, func(val string, _ Injector) (string, error) {
+		return val + "1", nil
+	})
+	Decorate(inj, func(val string, _ Injector) (string, error) {
+		return val + "2", nil
+	})
+
+	// -- Then
+	//
+	actual, _ := inj.Get(NewKey("s"))
+	d.Equal("s12", actual)
+}
+
+func (d *DecoratorTestSuite) TestDecoratorDependencies() {
+	// -- Given
+	//
+	inj := &injector{DepGraph: depgraph.NewDoubleMap[containerProvider[any]]()}
+	inj.Add(NewKey("prefix"), "prefix-")
+	inj.Add(NewKey("s"), "s")
+
+	Decorate(inj, func(val string, inj Injector) (string, error) {
+		if val == "prefix-" {
+			return val, nil
+		}
+		prefix, err := InjectorGet[string](inj, WithKey("prefix"))
+		return prefix + val, err
+	})
+
+	// -- When
+	//
+	actual, err := inj.Get(NewKey("s"))
+
+	// -- Then
+	//
+	if d.NoError(err) {
+		d.Equal("prefix-s", actual)
+		d.Equal([]any{NewKey("prefix")}, inj.DepGraph.GetDependencies(NewKey("s")))
+	}
+}
+
+func (d *DecoratorTestSuite) TestDecoratedField() {
+	// -- Given
+	//
+	type test struct {
+		T testInterface `inject:",type"`
+	}
+
+	inj := NewInjector()
+	inj.Add(NewTypeKey[testInterface](testInterfaceVal{Int: 1}))
+	Decorate(inj, func(val testInterface, _ Injector) (testInterface, error) {
+		return &decoratedInterface{testInterface: val}, nil
+	})
+	actual := new(test)
+
+	// -- When
+	//
+	err := inj.Inject(actual)
+
+	// -- Then
+	//
+	if d.NoError(err) {
+		d.Equal(&test{T: &decoratedInterface{testInterface: testInterfaceVal{Int: 1}}}, actual)
+	}
+}
+
+func (d *DecoratorTestSuite) TestDecoratorError() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("s"), "s")
+	Decorate(inj, func(val string, _ Injector) (string, error) {
+		return "", errors.New("error")
+	})
+
+	// -- When
+	//
+	actual, err := inj.Get(NewKey("s"))
+
+	// -- Then
+	//
+	d.EqualError(err, "error")
+	d.Nil(actual)
+}
+
+func (d *DecoratorTestSuite) TestFieldErrSkipsDecorators() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("dep"), new(testDep))
+	decorated := false
+	Decorate(inj, func(val *testDep, _ Injector) (*testDep, error) {
+		decorated = true
+		return val, nil
+	})
+
+	// -- When
+	//
+	_, err := inj.Get(NewKey("dep"))
+
+	// -- Then
+	//
+	d.EqualError(err, "failed to inject S: not found")
+	d.False(decorated)
+}
+
+type decoratedInterface struct {
+	testInterface
+}
+
+func TestDecoratorTestSuite(t *testing.T) {
+	suite.Run(t, new(DecoratorTestSuite))
+}
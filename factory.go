@@ -1,14 +1,22 @@
 package axon
 
+import (
+	"reflect"
+)
+
 // Factory produces the specified type whenever the Injector is retrieving the value (e.g. during Injector.Get or
 // Injector.Inject). This factory will only ever be called once to construct the value unless a downstream dependency
-// changes. Any Injector.Get method calls within the Build method will be registered as dependencies of the resulting type.
+// changes or the value is added WithScope(ScopeTransient). Any Injector.Get method calls within the Build method will
+// be registered as dependencies of the resulting type.
 type Factory interface {
 	Build(inj Injector) (any, error)
 }
 
 type internalFactory interface {
 	GetZeroValue() any
+
+	// GetType returns the type the factory builds.
+	GetType() reflect.Type
 }
 
 // NewFactory creates a Factory.
@@ -30,6 +38,10 @@ func (f *factory[T]) GetZeroValue() any {
 	return f.Val
 }
 
+func (f *factory[T]) GetType() reflect.Type {
+	return reflect.TypeOf(new(T)).Elem()
+}
+
 func (f *factory[T]) Build(inj Injector) (any, error) {
 	return f.FactoryFunc.Build(inj)
 }
@@ -39,3 +51,11 @@ type FactoryFunc[T any] func(inj Injector) (T, error)
 func (f FactoryFunc[T]) Build(inj Injector) (any, error) {
 	return f(inj)
 }
+
+func (f FactoryFunc[T]) GetZeroValue() any {
+	return *new(T)
+}
+
+func (f FactoryFunc[T]) GetType() reflect.Type {
+	return reflect.TypeOf(new(T)).Elem()
+}
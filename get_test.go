@@ -0,0 +1,242 @@
+package axon
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type GetOptsTestSuite struct {
+	suite.Suite
+}
+
+func (g *GetOptsTestSuite) TestNoConstruct() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("port"), NewFactory[int](func(_ Injector) (int, error) {
+		return 8080, nil
+	}))
+
+	// -- When
+	//
+	_, err := inj.Get(NewKey("port"), WithNoConstruct())
+
+	// -- Then
+	//
+	g.ErrorIs(err, ErrNotConstructed)
+	info, _ := inj.Describe(NewKey("port"))
+	g.False(info.Instantiated)
+
+	_, err = inj.Get(NewKey("port"))
+	g.Require().NoError(err)
+	actual, err := inj.Get(NewKey("port"), WithNoConstruct())
+	if g.NoError(err) {
+		g.Equal(8080, actual)
+	}
+}
+
+func (g *GetOptsTestSuite) TestDefault() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("port"), 8080)
+	inj.Add(NewKey("server"), &struct {
+		DB string `inject:"db"`
+	}{})
+
+	// -- When
+	//
+	actual, err := inj.Get(NewKey("missing"), WithDefault(1))
+
+	// -- Then
+	//
+	if g.NoError(err) {
+		g.Equal(1, actual)
+	}
+
+	found, err := inj.Get(NewKey("port"), WithDefault(1))
+	if g.NoError(err) {
+		g.Equal(8080, found)
+	}
+
+	nilDefault, err := inj.Get(NewKey("missing"), WithDefault(nil))
+	g.NoError(err)
+	g.Nil(nilDefault)
+
+	_, err = inj.Get(NewKey("server"), WithDefault(1))
+	g.ErrorIs(err, ErrNotFound)
+}
+
+func (g *GetOptsTestSuite) TestTimeout() {
+	// -- Given
+	//
+	release := make(chan struct{})
+	inj := NewInjector()
+	inj.Add(NewKey("slow"), NewFactory[int](func(_ Injector) (int, error) {
+		<-release
+		return 1, nil
+	}))
+
+	// -- When
+	//
+	_, err := inj.Get(NewKey("slow"), WithTimeout(time.Millisecond))
+
+	// -- Then
+	//
+	g.ErrorIs(err, ErrTimeout)
+	close(release)
+
+	actual, err := inj.Get(NewKey("slow"), WithTimeout(time.Minute))
+	if g.NoError(err) {
+		g.Equal(1, actual)
+	}
+}
+
+func (g *GetOptsTestSuite) TestTimeoutConcurrentUse() {
+	// -- Given
+	//
+	release := make(chan struct{})
+	inj := NewInjector()
+	inj.Add(NewKey("port"), 8080)
+	inj.Add(NewKey("slow"), NewFactory[int](func(inj Injector) (int, error) {
+		<-release
+		port, err := inj.Get(NewKey("port"))
+		if err != nil {
+			return 0, err
+		}
+		return port.(int), nil
+	}))
+	_, err := inj.Get(NewKey("slow"), WithTimeout(time.Millisecond))
+	g.Require().ErrorIs(err, ErrTimeout)
+
+	// -- When
+	//
+	close(release)
+	for j := 0; j < 100; j++ {
+		inj.Add(NewKey(j), j)
+		_, err = inj.Get(NewKey(j))
+		g.Require().NoError(err)
+		_, err = inj.Describe(NewKey("slow"))
+		g.Require().NoError(err)
+	}
+	actual, err := inj.Get(NewKey("slow"))
+
+	// -- Then
+	//
+	if g.NoError(err) {
+		g.Equal(8080, actual)
+		info, _ := inj.Describe(NewKey("slow"))
+		g.Equal([]Key{NewKey("port")}, info.Dependencies)
+	}
+}
+
+func (g *GetOptsTestSuite) TestTimeoutReplaced() {
+	// -- Given
+	//
+	started := make(chan struct{})
+	release := make(chan struct{})
+	orphan := new(destroyable)
+	inj := NewInjector()
+	inj.Add(NewKey("slow"), NewFactory[*destroyable](func(_ Injector) (*destroyable, error) {
+		close(started)
+		<-release
+		return orphan, nil
+	}))
+	_, err := inj.Get(NewKey("slow"), WithTimeout(time.Millisecond))
+	g.Require().ErrorIs(err, ErrTimeout)
+	<-started
+
+	// -- When
+	//
+	go close(release)
+	inj.Add(NewKey("slow"), new(destroyable))
+	actual, err := inj.Get(NewKey("slow"))
+
+	// -- Then
+	//
+	g.True(orphan.Destroyed)
+	if g.NoError(err) {
+		g.NotSame(orphan, actual)
+	}
+}
+
+func (g *GetOptsTestSuite) TestNoConstructConcurrent() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("port"), NewFactory[int](func(_ Injector) (int, error) {
+		return 8080, nil
+	}))
+
+	// -- When
+	//
+	done := make(chan any)
+	go func() {
+		for {
+			val, err := inj.Get(NewKey("port"), WithNoConstruct())
+			if err == nil {
+				done <- val
+				return
+			}
+		}
+	}()
+	_, err := inj.Get(NewKey("port"))
+
+	// -- Then
+	//
+	g.NoError(err)
+	g.Equal(8080, <-done)
+}
+
+func (g *GetOptsTestSuite) TestWithinFactory() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("server"), NewFactory[int](func(inj Injector) (int, error) {
+		port, err := inj.Get(NewKey("port"), WithDefault(8080))
+		if err != nil {
+			return 0, err
+		}
+		return port.(int), nil
+	}))
+
+	// -- When
+	//
+	actual, err := inj.Get(NewKey("server"))
+
+	// -- Then
+	//
+	if g.NoError(err) {
+		g.Equal(8080, actual)
+	}
+}
+
+func (g *GetOptsTestSuite) TestInjectorGet() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("lazy"), NewFactory[int](func(_ Injector) (int, error) {
+		return 1, nil
+	}))
+
+	// -- When
+	//
+	actual, err := InjectorGet[int](inj, WithKey("port"), WithGetOpts(WithDefault(8080)))
+
+	// -- Then
+	//
+	if g.NoError(err) {
+		g.Equal(8080, actual)
+	}
+
+	_, err = InjectorGet[int](inj, WithKey("lazy"), WithGetOpts(WithNoConstruct(), WithTimeout(time.Second)))
+	g.ErrorIs(err, ErrNotConstructed)
+
+	_, err = InjectorGet[int](inj, WithKey("port"), WithGetOpts(WithDefault("8080")))
+	g.ErrorIs(err, ErrInvalidType)
+}
+
+func TestGetOptsTestSuite(t *testing.T) {
+	suite.Run(t, new(GetOptsTestSuite))
+}
@@ -5,9 +5,14 @@ import (
 	"fmt"
 	"github.com/eddieowens/axon/internal/depgraph"
 	"github.com/eddieowens/axon/internal/mirror"
+	"github.com/eddieowens/axon/maps"
 	"github.com/eddieowens/axon/opts"
 	"reflect"
+	"runtime"
+	"runtime/debug"
 	"strings"
+	"sync"
+	"time"
 )
 
 var (
@@ -17,6 +22,26 @@ var (
 	// InjectTagValueType instructs the Injector to use the type as a Key rather than the name. If a name is specified,
 	// the name takes precedence.
 	InjectTagValueType = "type"
+
+	// InjectTagValueAll instructs the Injector to inject every value whose type is assignable to the element type of a
+	// slice field e.g.
+	//
+	//    type Server struct {
+	//        HealthCheckers []HealthChecker `inject:",all"`
+	//    }
+	//
+	// See GetAll for which values are included and when the field is injected again.
+	InjectTagValueAll = "all"
+
+	// InjectMethod is the name of the method the Injector calls on a struct once its fields are injected. The parameters
+	// of the method are resolved by their type key (see NewTypeKey) and the method may return an error e.g.
+	//
+	//    func (s *Server) InjectDependencies(db DatabaseClient, log *slog.Logger) error {
+	//        ...
+	//    }
+	//
+	// See WithSetters for calling other methods.
+	InjectMethod = "InjectDependencies"
 )
 
 var (
@@ -31,57 +56,352 @@ var (
 
 	// ErrInvalidField the field is not settable.
 	ErrInvalidField = errors.New("invalid field")
+
+	// ErrInvalidMethod the method can't be called by the Injector. See InjectMethod.
+	ErrInvalidMethod = errors.New("invalid method")
+
+	// ErrNotConstructed the value has not been constructed yet. See WithNoConstruct.
+	ErrNotConstructed = errors.New("not constructed")
+
+	// ErrTimeout the value took too long to construct. See WithTimeout.
+	ErrTimeout = errors.New("timed out")
 )
 
+// ConstructionPanicError returned when constructing a value panics e.g. within a Factory. The value is left
+// unconstructed so the next call to Get tries again.
+type ConstructionPanicError struct {
+	// The Key of the value that panicked.
+	Key Key
+
+	// The value passed to panic.
+	Value any
+
+	// The stack trace of the goroutine at the time of the panic.
+	Stack []byte
+}
+
+func (c *ConstructionPanicError) Error() string {
+	return fmt.Sprintf("panic while constructing %s: %v", c.Key.String(), c.Value)
+}
+
+// Unwrap returns the value passed to panic if it's an error.
+func (c *ConstructionPanicError) Unwrap() error {
+	err, _ := c.Value.(error)
+	return err
+}
+
+// recoverPanic sets err to a ConstructionPanicError if the caller panicked. Must be deferred directly.
+func recoverPanic(key Key, err *error) {
+	if r := recover(); r != nil {
+		*err = &ConstructionPanicError{Key: key, Value: r, Stack: debug.Stack()}
+	}
+}
+
+// FieldError returned for each field that failed to be injected when using WithAllFieldErrs.
+type FieldError struct {
+	// The path to the field e.g. Server.DB.
+	Field string
+
+	// The Key within the field's InjectTag. Empty if the tag has no Key e.g. when injecting all values.
+	Key Key
+
+	// Why the field failed to be injected.
+	Err error
+}
+
+func (f *FieldError) Error() string {
+	if f.Key.IsEmpty() {
+		return fmt.Sprintf("field %s: %v", f.Field, f.Err)
+	}
+	return fmt.Sprintf("field %s (key %s): %v", f.Field, f.Key.String(), f.Err)
+}
+
+func (f *FieldError) Unwrap() error {
+	return f.Err
+}
+
 // Injector allows for the storage, retrieval, and construction of objects.
 type Injector interface {
 	// Inject injects all fields on a struct that are tagged with the InjectTag from the Injector. d must be a pointer to
 	// a struct and the fields that are tagged must be public. If the InjectTag is not present on the struct or if the
-	// value is already set, it will not be injected.
+	// value is already set, it will not be injected. Once the fields are injected, the InjectMethod is called if d has
+	// one.
 	//
 	// All errors should be checked with errors.Is as they may be wrapped.
 	Inject(d any, opts ...opts.Opt[InjectorInjectOpts]) error
 
+	// Remove removes the value indexed by a Key. If the value was constructed by a Factory and implements PreDestroy, it
+	// is destroyed and the error from PreDestroy.Destroy is returned. If the Key is not found, ErrNotFound is returned.
+	Remove(key Key) error
+
+	// Shutdown destroys every value within the Injector that was constructed by a Factory and implements PreDestroy.
+	// Values are destroyed before the values they depend on and otherwise in the order they were added. All values are
+	// destroyed even if an error is encountered in which case the first error is returned. The Injector can still be
+	// used after Shutdown is called; values are constructed again on the next call to Get or Inject.
+	Shutdown() error
+
 	// Add adds the val indexed by a Key. The underlying value for a Key should be a comparable value since the underlying
 	// implementation utilizes a map. All calls to Add will overwrite existing values and no checks are done. Be aware that
-	// if Add overwrites an existing value, all of that values dependencies will be reconstructed on the next call to Get or
-	// Inject.
+	// if Add overwrites an existing value, every value that depends on it, either directly or transitively, is invalidated
+	// and will be reconstructed on the next call to Get or Inject. See WithNoCascade to opt a value out of this.
 	//
 	// If you want any updates made here to be reflected within the value themselves, use a provider.
 	Add(key Key, val any, ops ...opts.Opt[InjectorAddOpts])
 
+	// Refresh forces the value indexed by a Key to be constructed again e.g. rerunning its Factory. By default, only the
+	// value itself is refreshed and it's reconstructed on the next call to Get. See WithRefreshDependents and
+	// WithEagerRefresh to change this. If the Key is not found, ErrNotFound is returned.
+	//
+	// References to the old value that were already handed out are kept up to date where possible. If the old value is a
+	// MutableValue, the new value is pushed into it via MutableValue.SetValue and the old value is kept. Likewise, any
+	// MutableValue fields of values that depend on the Key but aren't refreshed are updated with the new value.
+	Refresh(key Key, ops ...opts.Opt[InjectorRefreshOpts]) error
+
 	// Get gets a value given a Key. If Get is unable to find the Key, ErrNotFound is returned. The first call to Get will
-	// cause the underlying value to be constructed if it is a Factory.
+	// cause the underlying value to be constructed if it is a Factory. See WithNoConstruct, WithDefault, and WithTimeout
+	// to change this.
 	Get(k Key, o ...opts.Opt[InjectorGetOpts]) (any, error)
+
+	// Snapshot captures every binding within the Injector along with their dependencies. The returned Snapshot can be
+	// passed to Restore to roll the Injector back to this point in time.
+	Snapshot() Snapshot
+
+	// Describe returns the BindingInfo for the value indexed by a Key. If the Key is not found, ErrNotFound is returned.
+	Describe(key Key) (BindingInfo, error)
+
+	// Keys returns every Key within the Injector in the order they were first added.
+	Keys() []Key
+
+	// Range calls r with the BindingInfo of every value within the Injector in the order they were first added. If r
+	// returns false, Range stops. Every BindingInfo is taken at the time Range is called so they're consistent with one
+	// another even if the Injector is used concurrently. Values removed before r is called for them are skipped.
+	Range(r maps.RangeFunc[Key, BindingInfo])
+
+	// AddDecorator adds a Decorator which is applied to every value constructed by the Injector. Decorators are applied
+	// in the order they are added and only to values constructed after the call to AddDecorator. See Decorate.
+	AddDecorator(d Decorator)
+
+	// AddObserver adds an Observer which receives an Event for everything the Injector does e.g. adding Keys and
+	// constructing values.
+	AddObserver(o Observer)
+
+	// Restore rolls the Injector back to the state captured by Snapshot. Bindings added after the Snapshot was taken are
+	// dropped and bindings that were overwritten are brought back along with any of their dependents. Values constructed
+	// after the Snapshot was taken are destroyed (see PreDestroy). A Snapshot can be restored any number of times.
+	Restore(s Snapshot)
 }
 
 // InjectorGetOpts opts for the Injector.Get method.
 type InjectorGetOpts struct {
+	// See WithNoConstruct.
+	NoConstruct bool
+
+	// See WithDefault. HasDefault is true if a Default was set as the Default may be nil.
+	Default    any
+	HasDefault bool
+
+	// See WithTimeout.
+	Timeout time.Duration
 }
 
 // InjectorInjectOpts opts for the Injector.Inject method.
 type InjectorInjectOpts struct {
 	// See WithSkipFieldErrs.
 	SkipFieldErr bool
+
+	// See WithInjectSetters.
+	Setters []string
+
+	// See WithAllFieldErrs.
+	AllFieldErrs bool
 }
 
 // InjectorAddOpts opts for the Injector.Add method.
 type InjectorAddOpts struct {
+	// See WithSecret.
+	Secret bool
+
+	// See WithDescription.
+	Description string
+
+	// See WithLabels.
+	Labels map[string]string
+
+	// See WithSource.
+	Source string
+
+	// See WithNoCascade.
+	NoCascade bool
+
+	// See WithRetryPolicy.
+	RetryPolicy RetryPolicy
+
+	// See WithScope.
+	Scope Scope
+
+	// See WithSetters.
+	Setters []string
+}
+
+// InjectorOpts opts for NewInjector.
+type InjectorOpts struct {
+	// See WithObserver.
+	Observers []Observer
+
+	// See WithTracer.
+	Tracer Tracer
+}
+
+// WithSecret marks the value being added as a secret. Secret values are never exposed by the Injector e.g. via an
+// Event or a log. Instead, the value is redacted.
+func WithSecret() opts.Opt[InjectorAddOpts] {
+	return func(opts *InjectorAddOpts) {
+		opts.Secret = true
+	}
+}
+
+// WithDescription adds a human-readable description to the value being added. See Injector.Describe.
+func WithDescription(description string) opts.Opt[InjectorAddOpts] {
+	return func(opts *InjectorAddOpts) {
+		opts.Description = description
+	}
+}
+
+// WithLabels adds labels to the value being added e.g. the team that owns the value. Calling WithLabels multiple times
+// merges the labels. See Injector.Describe.
+func WithLabels(labels map[string]string) opts.Opt[InjectorAddOpts] {
+	return func(opts *InjectorAddOpts) {
+		if opts.Labels == nil {
+			opts.Labels = make(map[string]string, len(labels))
+		}
+		for k, v := range labels {
+			opts.Labels[k] = v
+		}
+	}
+}
+
+// WithSource records the file and line WithSource was called from as the source of the value being added. See
+// Injector.Describe.
+//
+//    inj.Add(axon.NewKey("db"), db, axon.WithSource()) // source is recorded as e.g. /app/main.go:12
+func WithSource() opts.Opt[InjectorAddOpts] {
+	source := ""
+	if _, file, line, ok := runtime.Caller(1); ok {
+		source = fmt.Sprintf("%s:%d", file, line)
+	}
+
+	return func(opts *InjectorAddOpts) {
+		opts.Source = source
+	}
+}
+
+// WithNoCascade stops the value being added from being invalidated when one of its dependencies is overwritten via
+// Injector.Add. Values that depend on this value are also left alone unless they're reachable through another
+// dependency. Use this for values which keep themselves up to date through a MutableValue such as a Provider.
+func WithNoCascade() opts.Opt[InjectorAddOpts] {
+	return func(opts *InjectorAddOpts) {
+		opts.NoCascade = true
+	}
+}
+
+// WithScope sets the lifetime of the value being added. Defaults to ScopeSingleton. ScopeTransient only applies to a
+// Factory or a ParamFactory; other values are always singletons. Transient values are never tracked by the Injector so
+// they aren't destroyed on Shutdown.
+//
+//    inj.Add(axon.NewKey("conn"), axon.NewFactory[*Conn](dial), axon.WithScope(axon.ScopeTransient))
+func WithScope(scope Scope) opts.Opt[InjectorAddOpts] {
+	return func(opts *InjectorAddOpts) {
+		opts.Scope = scope
+	}
+}
+
+// WithSetters calls the named methods on the value being added whenever it's constructed, after the InjectMethod.
+// Similar to the InjectMethod, the parameters of each method are resolved by their type key. If the value doesn't have
+// one of the methods, constructing it fails with ErrInvalidMethod.
+//
+//    inj.Add(axon.NewKey("server"), new(Server), axon.WithSetters("SetDB", "SetLogger"))
+func WithSetters(names ...string) opts.Opt[InjectorAddOpts] {
+	return func(opts *InjectorAddOpts) {
+		opts.Setters = append(opts.Setters, names...)
+	}
+}
+
+// WithObserver adds an Observer to the Injector. See Injector.AddObserver.
+func WithObserver(o Observer) opts.Opt[InjectorOpts] {
+	return func(opts *InjectorOpts) {
+		opts.Observers = append(opts.Observers, o)
+	}
 }
 
 // WithSkipFieldErrs allows for the Injector.Inject method to skip over field errors that are encountered when attempting
 // to inject values onto a struct. The Injector.Inject method may still return an error, but they will not be due to problems
-// encountered on individual fields.
+// encountered on individual fields. If any field is skipped, no methods are called e.g. InjectDependencies or those
+// named via WithInjectSetters.
 func WithSkipFieldErrs() opts.Opt[InjectorInjectOpts] {
 	return func(opts *InjectorInjectOpts) {
 		opts.SkipFieldErr = true
 	}
 }
 
+// WithAllFieldErrs injects every field rather than stopping at the first field that fails. The returned error joins a
+// FieldError for each field that failed (see errors.Join) while every other field is still set. If any field fails, no
+// methods are called e.g. InjectDependencies or those named via WithInjectSetters. Takes precedence over
+// WithSkipFieldErrs.
+//
+//    err := inj.Inject(cfg, axon.WithAllFieldErrs())
+//    var fieldErr *axon.FieldError
+//    if errors.As(err, &fieldErr) {
+//        ...
+//    }
+func WithAllFieldErrs() opts.Opt[InjectorInjectOpts] {
+	return func(opts *InjectorInjectOpts) {
+		opts.AllFieldErrs = true
+	}
+}
+
+// WithNoConstruct only gets the value if it has already been constructed. If it hasn't, ErrNotConstructed is returned
+// rather than constructing it.
+func WithNoConstruct() opts.Opt[InjectorGetOpts] {
+	return func(opts *InjectorGetOpts) {
+		opts.NoConstruct = true
+	}
+}
+
+// WithDefault returns val rather than ErrNotFound if the Key is not found. Errors from constructing a value that is
+// found, including one of its dependencies not being found, are still returned.
+//
+//    port, err := inj.Get(axon.NewKey("port"), axon.WithDefault(8080))
+func WithDefault(val any) opts.Opt[InjectorGetOpts] {
+	return func(opts *InjectorGetOpts) {
+		opts.Default = val
+		opts.HasDefault = true
+	}
+}
+
+// WithTimeout stops waiting for the value to be constructed after d and returns ErrTimeout. The construction itself is
+// not cancelled; it carries on in the background and the value is available to subsequent calls to Get once it's
+// done. The Injector can be used as normal in the meantime. Calls to Get, Add, or Remove for the same Key wait for the
+// construction to finish. If the Key was replaced or removed, the value that was constructed is then destroyed.
+func WithTimeout(d time.Duration) opts.Opt[InjectorGetOpts] {
+	return func(opts *InjectorGetOpts) {
+		opts.Timeout = d
+	}
+}
+
+// WithInjectSetters calls the named methods on the struct passed to Injector.Inject. See WithSetters.
+func WithInjectSetters(names ...string) opts.Opt[InjectorInjectOpts] {
+	return func(opts *InjectorInjectOpts) {
+		opts.Setters = append(opts.Setters, names...)
+	}
+}
+
 // NewInjector constructs a new Injector.
-func NewInjector() Injector {
+func NewInjector(ops ...opts.Opt[InjectorOpts]) Injector {
+	o := opts.ApplyOpts(&InjectorOpts{}, ops...)
 	return &injector{
-		DepGraph: depgraph.NewDoubleMap[containerProvider[any]](),
+		DepGraph:  depgraph.NewDoubleMap[containerProvider[any]](),
+		Observers: o.Observers,
+		Tracer:    o.Tracer,
 	}
 }
 
@@ -101,7 +421,18 @@ type MutableValue interface {
 var mutableValueType = reflect.TypeOf((*MutableValue)(nil)).Elem()
 
 type injector struct {
-	DepGraph depgraph.DoubleMap[any, containerProvider[any]]
+	// Guards DepGraph, Refreshed, Decorators and Observers. It's only ever held while they're being read or written and
+	// never while a value is being constructed or destroyed or an Event is emitted as Factories, lifecycle hooks, and
+	// Observers call back into the injector.
+	Lock sync.RWMutex
+
+	// Keys that were refreshed and whose dependents' MutableValues need the new value once it's constructed.
+	Refreshed map[any]bool
+
+	DepGraph   depgraph.DoubleMap[any, containerProvider[any]]
+	Decorators []Decorator
+	Observers  []Observer
+	Tracer     Tracer
 }
 
 func (i *injector) Inject(d any, opts ...opts.Opt[InjectorInjectOpts]) error {
@@ -115,105 +446,477 @@ func (i *injector) Inject(d any, opts ...opts.Opt[InjectorInjectOpts]) error {
 		return ErrPtrToStruct
 	}
 
-	return i.injectStructWithOpts(Key{}, val, opts...)
+	err := i.injectStructWithOpts(Key{}, val, nil, opts...)
+	i.emit(Event{Type: EventInjectCompleted, Value: d, Err: err})
+	return err
 }
 
-func (i *injector) Add(key Key, val any, _ ...opts.Opt[InjectorAddOpts]) {
-	v := key.resolve(i.DepGraph)
+func (i *injector) Add(key Key, val any, ops ...opts.Opt[InjectorAddOpts]) {
+	o := opts.ApplyOpts(&InjectorAddOpts{}, ops...)
+	v := i.lookup(key)
 	exists := v != nil
-	var err error
+	updated := false
 	if exists && v.IsInstantiated() {
 		if mut, ok := v.GetValue().(MutableValue); ok {
-			err = mut.SetValue(val)
+			updated = mut.SetValue(val) == nil
 		}
 	}
 
-	if err != nil || !exists {
-		v = newContainerProvider(i, val)
+	if !updated {
+		if exists {
+			i.invalidateDependents(key, InvalidationCascade)
+			_ = i.destroy(key, v, InvalidationOverwritten, key)
+		}
+		v = newContainerProvider(i, key, val)
+	}
+	v.SetAddOpts(o)
+	v.SetConstructor(i.onConstruct(key, o))
+
+	// the value is only visible to other callers once it's fully set up.
+	i.Lock.Lock()
+	if !updated {
 		i.DepGraph.Add(key, v)
 	}
+	if exists {
+		i.DepGraph.RemoveDependencies(key)
+	}
+	i.Lock.Unlock()
+
+	if exists {
+		v.Invalidate()
+		i.emit(Event{Type: EventKeyOverwritten, Key: key, Value: val, Secret: o.Secret})
+	} else {
+		i.emit(Event{Type: EventKeyAdded, Key: key, Value: val, Secret: o.Secret})
+	}
+}
 
-	v.SetConstructor(func(constructed container[any]) error {
-		val := mirror.StripPtrs(constructed.GetReflectValue())
+// onConstruct returns the OnConstructFunc for values indexed by the key which injects the value's fields, calls
+// PostConstruct, and applies the Decorators.
+func (i *injector) onConstruct(key Key, o InjectorAddOpts) OnConstructFunc[any] {
+	return func(constructed any, kt *keyTracker) (any, error) {
+		val := mirror.StripPtrs(reflect.ValueOf(constructed))
 
 		if val.Kind() == reflect.Struct {
-			err = i.injectStructWithOpts(key, val)
+			err := i.injectStructWithOpts(key, val, kt.span, WithInjectSetters(o.Setters...))
+			if err != nil {
+				return nil, err
+			}
 		}
-		return err
-	})
-	if exists {
-		v.Invalidate()
-		i.DepGraph.RemoveDependencies(key)
+
+		if pc, ok := constructed.(PostConstruct); ok {
+			err := pc.Init()
+			if err != nil {
+				return nil, err
+			}
+		}
+		return i.decorate(constructed, kt)
+	}
+}
+
+func (i *injector) Get(k Key, ops ...opts.Opt[InjectorGetOpts]) (any, error) {
+	return i.getWithOpts(k, nil, opts.ApplyOpts(&InjectorGetOpts{}, ops...))
+}
+
+func (i *injector) getWithOpts(k Key, parent Span, o InjectorGetOpts) (any, error) {
+	v := i.lookup(k)
+	if v == nil {
+		if o.HasDefault {
+			return o.Default, nil
+		}
+		return nil, ErrNotFound
+	}
+
+	if o.NoConstruct && !v.IsInstantiated() {
+		return nil, fmt.Errorf("%s: %w", k.String(), ErrNotConstructed)
+	}
+
+	if o.Timeout <= 0 {
+		return i.get(k, parent)
+	}
+
+	type result struct {
+		val any
+		err error
+	}
+
+	// buffered so the construction can finish after the timeout without blocking.
+	ch := make(chan result, 1)
+	go func() {
+		val, err := i.get(k, parent)
+		ch <- result{val: val, err: err}
+	}()
+
+	timer := time.NewTimer(o.Timeout)
+	defer timer.Stop()
+	select {
+	case r := <-ch:
+		return r.val, r.err
+	case <-timer.C:
+		return nil, fmt.Errorf("%s after %s: %w", k.String(), o.Timeout, ErrTimeout)
 	}
 }
 
-func (i *injector) Get(k Key, _ ...opts.Opt[InjectorGetOpts]) (any, error) {
-	v := k.resolve(i.DepGraph)
+// get same as Get but parent is the Span of the value that depends on k, if any.
+func (i *injector) get(k Key, parent Span) (any, error) {
+	v := i.lookup(k)
 	if v == nil {
 		return nil, ErrNotFound
 	}
 
-	con, err := v.ProvideContainer()
+	con, err := i.provideContainer(k, v, parent)
+	if err != nil {
+		return nil, err
+	}
+
+	return con.GetValue(), nil
+}
+
+func (i *injector) Remove(key Key) error {
+	i.Lock.Lock()
+	v := key.resolve(i.DepGraph)
+	if v == nil {
+		i.Lock.Unlock()
+		return ErrNotFound
+	}
+
+	i.DepGraph.Remove(key)
+	delete(i.Refreshed, key)
+	i.Lock.Unlock()
+
+	return i.destroy(key, v, InvalidationRemoved, key)
+}
+
+func (i *injector) Shutdown() error {
+	var firstErr error
+	visited := map[any]bool{}
+
+	var destroy func(key any)
+	destroy = func(key any) {
+		if visited[key] {
+			return
+		}
+		visited[key] = true
+
+		for _, dependent := range i.dependents(key) {
+			destroy(dependent)
+		}
+
+		v := i.lookup(key.(Key))
+		if v == nil {
+			return
+		}
+
+		err := i.destroy(key.(Key), v, InvalidationShutdown, Key{})
+		if err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("failed to destroy %v: %w", key, err)
+		}
+	}
+
+	for _, k := range i.Keys() {
+		destroy(k)
+	}
+
+	i.Lock.Lock()
+	i.Refreshed = nil
+	i.Lock.Unlock()
+
+	return firstErr
+}
+
+// invalidateDependents destroys every value that transitively depends on the key so that they're constructed again on
+// the next call to Get. Dependents are destroyed before the values they depend on. Values added WithNoCascade are
+// skipped along with anything that's only reachable through them. The Keys that were invalidated are returned in the
+// order they were destroyed.
+func (i *injector) invalidateDependents(key Key, reason InvalidationReason) []Key {
+	out := make([]Key, 0)
+	visited := map[any]bool{key: true}
+
+	var invalidate func(k any)
+	invalidate = func(k any) {
+		for _, dependent := range i.dependents(k) {
+			if visited[dependent] {
+				continue
+			}
+			visited[dependent] = true
+
+			v := i.lookup(dependent.(Key))
+			if v == nil || v.GetAddOpts().NoCascade {
+				continue
+			}
+
+			invalidate(dependent)
+			_ = i.destroy(dependent.(Key), v, reason, key)
+			out = append(out, dependent.(Key))
+		}
+	}
+
+	invalidate(key)
+	return out
+}
+
+// destroy destroys the value held by v. If the value was constructed, an EventInvalidated is emitted. Values destroyed
+// for InvalidationRefreshed are refreshed instead. See containerProvider.Refresh.
+func (i *injector) destroy(key Key, v containerProvider[any], reason InvalidationReason, cause Key) error {
+	var destroyed bool
+	var err error
+	if reason == InvalidationRefreshed {
+		destroyed, err = v.Refresh()
+	} else {
+		destroyed, err = v.Destroy()
+	}
+	if !destroyed {
+		return err
+	}
+
+	i.emit(Event{Type: EventInvalidated, Key: key, Reason: reason, Cause: cause, Err: err, Secret: v.GetAddOpts().Secret})
+	return err
+}
+
+func (i *injector) AddDecorator(d Decorator) {
+	i.Lock.Lock()
+	defer i.Lock.Unlock()
+	i.Decorators = append(i.Decorators, d)
+}
+
+// provideContainer calls containerProvider.ProvideContainer and registers all the external dependencies of the
+// container as dependencies of the key.
+func (i *injector) provideContainer(k Key, v containerProvider[any], parent Span) (container[any], error) {
+	con, err := v.ProvideContainer(parent)
 	if err != nil {
 		return nil, err
 	}
 
+	i.Lock.Lock()
 	for _, v := range con.GetExternalDependencies() {
 		i.DepGraph.AddDependencies(k, v)
 	}
 
-	return con.GetValue(), nil
+	refreshed := i.Refreshed[k]
+	delete(i.Refreshed, k)
+	i.Lock.Unlock()
+
+	if refreshed {
+		i.pushToDependents(k, con.GetValue())
+	}
+
+	return con, nil
+}
+
+func (i *injector) decorate(val any, inj Injector) (any, error) {
+	i.Lock.RLock()
+	decorators := i.Decorators
+	i.Lock.RUnlock()
+
+	var err error
+	for _, d := range decorators {
+		val, err = d.Decorate(val, inj)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return val, nil
 }
 
-func (i *injector) injectStructWithOpts(key Key, v reflect.Value, opts ...opts.Opt[InjectorInjectOpts]) error {
+func (i *injector) injectStructWithOpts(key Key, v reflect.Value, parent Span, opts ...opts.Opt[InjectorInjectOpts]) error {
 	o := &InjectorInjectOpts{}
 	for _, v := range opts {
 		v(o)
 	}
 
-	return i.injectStruct(key, v, *o)
+	return i.injectStruct(key, v, parent, *o)
 }
 
-func (i *injector) injectStruct(key Key, v reflect.Value, o InjectorInjectOpts) error {
+func (i *injector) injectStruct(key Key, v reflect.Value, parent Span, o InjectorInjectOpts) error {
+	var errs []error
+	skipped := false
 	for j := 0; j < v.NumField(); j++ {
-		err := i.injectStructField(key, v.Field(j), v.Type().Field(j))
+		strctField := v.Type().Field(j)
+		err := i.injectStructField(key, v.Field(j), strctField, parent)
 		if err != nil {
+			if o.AllFieldErrs {
+				errs = append(errs, &FieldError{
+					Field: fieldPath(v.Type(), strctField),
+					Key:   tagKey(strctField.Tag.Get(InjectTag), v.Field(j)),
+					Err:   err,
+				})
+				continue
+			}
 			if o.SkipFieldErr {
+				skipped = true
 				continue
 			}
 			return err
 		}
 	}
 
-	return nil
+	// methods are never called with a partially injected value.
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	if skipped {
+		return nil
+	}
+	return i.injectMethods(key, v, parent, o.Setters)
 }
 
-func (i *injector) injectStructField(key Key, field reflect.Value, strctField reflect.StructField) error {
+// fieldPath returns the path to the field e.g. Server.DB.
+func fieldPath(strct reflect.Type, field reflect.StructField) string {
+	if strct.Name() == "" {
+		return field.Name
+	}
+	return strct.Name() + "." + field.Name
+}
+
+func (i *injector) injectStructField(key Key, field reflect.Value, strctField reflect.StructField, parent Span) error {
 	depInjectTag := strctField.Tag.Get(InjectTag)
+	if parsed := parseTag(depInjectTag); parsed != nil && parsed.All {
+		return i.injectAll(key, field, strctField, parent)
+	}
+
+	if isLazyField(field) {
+		return i.injectLazy(key, field, strctField, depInjectTag)
+	}
+
+	if supplierKey := i.supplierKey(key, depInjectTag, field); !supplierKey.IsEmpty() {
+		return i.injectSupplier(key, field, strctField, supplierKey)
+	}
+
 	depKey := resolveKey(depInjectTag, field)
+	if depKey.IsEmpty() {
+		// fields that were injected by a previous construction are injected again so that the value is rebuilt with
+		// the latest values.
+		if k := tagKey(depInjectTag, field); !k.IsEmpty() && i.dependsOn(key, k) {
+			depKey = k
+		}
+	}
+
 	if !depKey.IsEmpty() {
-		con, err := i.resolveValue(depKey)
+		con, err := i.resolveValue(depKey, parent)
 		if err != nil {
 			return err
 		}
 
-		err = setReflectVal(field, con, depKey)
+		err = i.setReflectVal(field, con, depKey)
+		if err != nil {
+			return err
+		}
+
+		i.addDependencies(key, depKey)
+	}
+	return nil
+}
+
+// injectAll sets the slice field to every value assignable to its element type. See InjectTagValueAll.
+func (i *injector) injectAll(key Key, field reflect.Value, strctField reflect.StructField, parent Span) error {
+	if field.Kind() != reflect.Slice {
+		return fmt.Errorf("%w: field %s must be a slice to inject all values but got type %s", ErrInvalidField, strctField.Name, field.Type().String())
+	}
+
+	keys := i.assignableKeys(field.Type().Elem(), key)
+	if !field.IsZero() && !i.dependsOnAny(key, keys) {
+		return nil
+	}
+
+	if !field.CanSet() {
+		return fmt.Errorf("%w: field %s is not settable", ErrInvalidField, strctField.Name)
+	}
+
+	out := reflect.MakeSlice(field.Type(), 0, len(keys))
+	for _, k := range keys {
+		con, err := i.resolveValue(k, parent)
 		if err != nil {
 			return err
 		}
 
-		i.DepGraph.AddDependencies(key, depKey)
+		val := con.GetReflectValue()
+		if !val.IsValid() || !val.Type().AssignableTo(field.Type().Elem()) {
+			continue
+		}
+		out = reflect.Append(out, val)
+		i.addDependencies(key, k)
 	}
+
+	field.Set(out)
 	return nil
 }
 
-func (i *injector) resolveValue(key Key) (container[any], error) {
-	dep := key.resolve(i.DepGraph)
+// assignableKeys returns the Keys of every value whose type is assignable to typ in the order they were added excluding
+// the skip Key. Values whose type is unknown until they're constructed are skipped.
+func (i *injector) assignableKeys(typ reflect.Type, skip Key) []Key {
+	i.Lock.RLock()
+	defer i.Lock.RUnlock()
+
+	out := make([]Key, 0)
+	i.DepGraph.Range(func(k any, v containerProvider[any]) bool {
+		if t := v.GetType(); t != nil && k != skip && t.AssignableTo(typ) {
+			out = append(out, k.(Key))
+		}
+		return true
+	})
+	return out
+}
+
+// dependsOnAny returns true if key directly depends on any of the deps.
+func (i *injector) dependsOnAny(key Key, deps []Key) bool {
+	for _, d := range deps {
+		if i.dependsOn(key, d) {
+			return true
+		}
+	}
+	return false
+}
+
+// dependsOn returns true if key directly depends on dep.
+func (i *injector) dependsOn(key, dep Key) bool {
+	if key.IsEmpty() {
+		return false
+	}
+
+	for _, k := range i.dependencies(key) {
+		if k == dep {
+			return true
+		}
+	}
+	return false
+}
+
+// lookup returns the containerProvider indexed by the key or nil if the key isn't within the injector.
+func (i *injector) lookup(key Key) containerProvider[any] {
+	i.Lock.RLock()
+	defer i.Lock.RUnlock()
+	return key.resolve(i.DepGraph)
+}
+
+// addDependencies registers the deps as dependencies of the key.
+func (i *injector) addDependencies(key Key, deps ...Key) {
+	i.Lock.Lock()
+	defer i.Lock.Unlock()
+	for _, d := range deps {
+		i.DepGraph.AddDependencies(key, d)
+	}
+}
+
+// dependencies returns the keys the key directly depends on.
+func (i *injector) dependencies(key any) []any {
+	i.Lock.RLock()
+	defer i.Lock.RUnlock()
+	return i.DepGraph.GetDependencies(key)
+}
+
+// dependents returns the keys that directly depend on the key.
+func (i *injector) dependents(key any) []any {
+	i.Lock.RLock()
+	defer i.Lock.RUnlock()
+	return i.DepGraph.GetDependents(key)
+}
+
+func (i *injector) resolveValue(key Key, parent Span) (container[any], error) {
+	dep := i.lookup(key)
 	if dep == nil {
 		return nil, fmt.Errorf("failed to inject %s: %w", key.String(), ErrNotFound)
 	}
 
-	con, err := dep.ProvideContainer()
+	con, err := i.provideContainer(key, dep, parent)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get field %s: %w", key.String(), err)
 	}
@@ -238,7 +941,7 @@ func safeCast[T any](val any) (out T) {
 	return
 }
 
-func setReflectVal(field reflect.Value, container container[any], key Key) error {
+func (i *injector) setReflectVal(field reflect.Value, container container[any], key Key) error {
 	if !field.CanSet() {
 		return fmt.Errorf("%w: field %s is not settable", ErrInvalidField, key.String())
 	}
@@ -247,7 +950,7 @@ func setReflectVal(field reflect.Value, container container[any], key Key) error
 	containerVal := container.GetReflectValue()
 
 	// only applicable to check if MutableValue is used as a field on a struct
-	conIsMutable := containerVal.Type().Implements(mutableValueType)
+	conIsMutable := containerVal.IsValid() && containerVal.Type().Implements(mutableValueType)
 	if conIsMutable {
 		err := safeCast[MutableValue](rawVal).SetValue(rawVal)
 		if err != nil {
@@ -257,7 +960,7 @@ func setReflectVal(field reflect.Value, container container[any], key Key) error
 		if field.IsNil() {
 			err := mirror.Instantiate(field)
 			if err != nil {
-				return fmt.Errorf("%w: field %s is type %s but got type %s", ErrInvalidType, key.String(), field.Type().String(), containerVal.Type().String())
+				return fmt.Errorf("%w: field %s is type %s but got type %s", ErrInvalidType, key.String(), field.Type().String(), typeName(containerVal))
 			}
 		}
 
@@ -265,15 +968,56 @@ func setReflectVal(field reflect.Value, container container[any], key Key) error
 	}
 
 	err := mirror.Set(field, containerVal)
-	if err != nil {
-		return fmt.Errorf("%w: field %s is type %s but got type %s", ErrInvalidType, key.String(), field.Type().String(), containerVal.Type().String())
+	if err == nil {
+		return nil
+	}
+
+	converted, err := convert(containerVal, field.Type(), key)
+	if err == nil {
+		err = mirror.Set(field, converted)
+	}
+
+	if errors.Is(err, errNoConverter) {
+		return fmt.Errorf("%w: field %s is type %s but got type %s", ErrInvalidType, key.String(), field.Type().String(), typeName(containerVal))
+	} else if err != nil && i.isSecret(key) {
+		// conversion errors often contain the value being converted e.g. strconv errors.
+		return fmt.Errorf("%w: failed to convert field %s from type %s to type %s: %s", ErrInvalidType, key.String(), typeName(containerVal), field.Type().String(), RedactedValue)
+	} else if err != nil {
+		return fmt.Errorf("%w: failed to convert field %s from type %s to type %s: %w", ErrInvalidType, key.String(), typeName(containerVal), field.Type().String(), err)
 	}
 	return nil
 }
 
+// isSecret returns true if the value indexed by the key was added WithSecret.
+func (i *injector) isSecret(key Key) bool {
+	v := i.lookup(key)
+	return v != nil && v.GetAddOpts().Secret
+}
+
+// convert calls converters.Convert recovering any panic from within a ConverterFunc.
+func convert(src reflect.Value, dst reflect.Type, key Key) (out reflect.Value, err error) {
+	defer recoverPanic(key, &err)
+	return converters.Convert(src, dst)
+}
+
+func typeName(val reflect.Value) string {
+	if !val.IsValid() {
+		return "nil"
+	}
+	return val.Type().String()
+}
+
 func resolveKey(tag string, field reflect.Value) Key {
+	if !field.IsZero() {
+		return Key{}
+	}
+	return tagKey(tag, field)
+}
+
+// tagKey returns the Key the tag refers to regardless of whether the field has already been set.
+func tagKey(tag string, field reflect.Value) Key {
 	parsed := parseTag(tag)
-	if field.IsZero() && parsed != nil {
+	if parsed != nil {
 		if parsed.Name != "" {
 			return NewKey(parsed.Name)
 		} else if parsed.InjectType {
@@ -293,8 +1037,11 @@ func parseTag(tag string) *injectTag {
 	tagSplit := strings.Split(tag, ",")
 	if len(tagSplit) > 1 {
 		for _, v := range tagSplit[1:] {
-			if strings.TrimSpace(v) == InjectTagValueType {
+			switch strings.TrimSpace(v) {
+			case InjectTagValueType:
 				out.InjectType = true
+			case InjectTagValueAll:
+				out.All = true
 			}
 		}
 	}
@@ -312,4 +1059,8 @@ type injectTag struct {
 	// Corresponds to the InjectTagValueType field of the tag. If that value is present in the InjectTag, the type of the
 	// dependency is injected rather than a specific key.
 	InjectType bool
+
+	// Corresponds to the InjectTagValueAll field of the tag. If that value is present in the InjectTag, every value
+	// assignable to the element type of the field is injected.
+	All bool
 }
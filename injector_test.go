@@ -2,6 +2,7 @@ package axon
 
 import (
 	"errors"
+	"fmt"
 	"github.com/eddieowens/axon/internal/depgraph"
 	"github.com/stretchr/testify/suite"
 	"testing"
@@ -28,6 +29,247 @@ func (i *InjectorTestSuite) TestAdd() {
 	i.Equal(23, actual)
 }
 
+func (i *InjectorTestSuite) TestAddOverwrite() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("key"), 1)
+	inj.Add(NewKey("unused"), 1)
+	_, _ = inj.Get(NewKey("key"))
+
+	// -- When
+	//
+	inj.Add(NewKey("key"), 2)
+	inj.Add(NewKey("unused"), 2)
+
+	// -- Then
+	//
+	actual, _ := inj.Get(NewKey("key"))
+	i.Equal(2, actual)
+	actual, _ = inj.Get(NewKey("unused"))
+	i.Equal(2, actual)
+}
+
+func (i *InjectorTestSuite) TestAddOverwriteCascade() {
+	// -- Given
+	//
+	type service struct {
+		Name string `inject:"name"`
+	}
+	type server struct {
+		Service *service `inject:"service"`
+	}
+
+	builds := 0
+	inj := NewInjector()
+	inj.Add(NewKey("name"), "old")
+	inj.Add(NewKey("service"), NewFactory[*service](func(inj Injector) (*service, error) {
+		builds++
+		return new(service), nil
+	}))
+	inj.Add(NewKey("server"), new(server))
+	_, err := inj.Get(NewKey("server"))
+	i.Require().NoError(err)
+
+	// -- When
+	//
+	inj.Add(NewKey("name"), "new")
+
+	// -- Then
+	//
+	info, _ := inj.Describe(NewKey("server"))
+	i.False(info.Instantiated)
+
+	actual, err := inj.Get(NewKey("server"))
+	if i.NoError(err) {
+		i.Equal("new", actual.(*server).Service.Name)
+		i.Equal(2, builds)
+	}
+}
+
+func (i *InjectorTestSuite) TestAddOverwriteNoCascade() {
+	// -- Given
+	//
+	type service struct {
+		Name *Provider[string] `inject:"name"`
+	}
+	type server struct {
+		Service *service `inject:"service"`
+	}
+
+	inj := NewInjector()
+	inj.Add(NewKey("name"), NewProvider("old"))
+	inj.Add(NewKey("other"), "other")
+	inj.Add(NewKey("service"), new(service), WithNoCascade())
+	inj.Add(NewKey("server"), new(server))
+	_, err := inj.Get(NewKey("server"))
+	i.Require().NoError(err)
+
+	// -- When
+	//
+	inj.Add(NewKey("name"), "new")
+
+	// -- Then
+	//
+	for _, k := range []string{"service", "server"} {
+		info, _ := inj.Describe(NewKey(k))
+		i.True(info.Instantiated, k)
+	}
+}
+
+func (i *InjectorTestSuite) TestAddOverwriteCascadeDiamond() {
+	// -- Given
+	//
+	type shielded struct {
+		Name string `inject:"name"`
+	}
+	type direct struct {
+		Name string `inject:"name"`
+	}
+	type top struct {
+		Shielded *shielded `inject:"shielded"`
+		Direct   *direct   `inject:"direct"`
+		Name     string    `inject:"name"`
+	}
+
+	events := make([]string, 0)
+	inj := NewInjector(WithObserver(ObserverFunc(func(e Event) {
+		if e.Type == EventInvalidated {
+			events = append(events, fmt.Sprintf("%s %s %s", e.Key, e.Reason, e.Cause))
+		}
+	})))
+	inj.Add(NewKey("name"), "old")
+	inj.Add(NewKey("shielded"), new(shielded), WithNoCascade())
+	inj.Add(NewKey("direct"), new(direct))
+	inj.Add(NewKey("top"), new(top))
+	_, err := inj.Get(NewKey("top"))
+	i.Require().NoError(err)
+
+	// -- When
+	//
+	inj.Add(NewKey("name"), "new")
+
+	// -- Then
+	//
+	i.Equal([]string{"top Cascade name", "direct Cascade name", "name Overwritten name"}, events)
+	actual, err := inj.Get(NewKey("top"))
+	if i.NoError(err) {
+		i.Equal("new", actual.(*top).Direct.Name)
+		i.Equal("new", actual.(*top).Name)
+		i.Equal("old", actual.(*top).Shielded.Name)
+	}
+}
+
+func (i *InjectorTestSuite) TestAddPrefilledField() {
+	// -- Given
+	//
+	type test struct {
+		Name string `inject:"name"`
+	}
+
+	inj := NewInjector()
+	inj.Add(NewKey("name"), "injected")
+	inj.Add(NewKey("test"), &test{Name: "prefilled"})
+	given := &test{Name: "prefilled"}
+
+	// -- When
+	//
+	actual, err := inj.Get(NewKey("test"))
+	injectErr := inj.Inject(given)
+
+	// -- Then
+	//
+	if i.NoError(err) && i.NoError(injectErr) {
+		i.Equal("prefilled", actual.(*test).Name)
+		i.Equal("prefilled", given.Name)
+	}
+}
+
+func (i *InjectorTestSuite) TestInjectAll() {
+	// -- Given
+	//
+	type server struct {
+		Checkers []healthChecker `inject:",all"`
+	}
+
+	inj := NewInjector()
+	inj.Add(NewKey("a"), &checker{Name: "a"})
+	inj.Add(NewKey("server"), new(server))
+	inj.Add(NewKey("b"), &checker{Name: "b"})
+	inj.Add(NewKey("nil"), NewFactory[healthChecker](func(_ Injector) (healthChecker, error) {
+		return nil, nil
+	}))
+
+	// -- When
+	//
+	actual, err := inj.Get(NewKey("server"))
+
+	// -- Then
+	//
+	if i.NoError(err) {
+		i.Equal([]healthChecker{&checker{Name: "a"}, &checker{Name: "b"}}, actual.(*server).Checkers)
+		info, _ := inj.Describe(NewKey("server"))
+		i.Equal([]Key{NewKey("a"), NewKey("b")}, info.Dependencies)
+
+		inj.Add(NewKey("a"), &checker{Name: "new"})
+		actual, _ = inj.Get(NewKey("server"))
+		i.Equal([]healthChecker{&checker{Name: "new"}, &checker{Name: "b"}}, actual.(*server).Checkers)
+	}
+}
+
+func (i *InjectorTestSuite) TestInjectAllInvalid() {
+	// -- Given
+	//
+	type notSlice struct {
+		Checker healthChecker `inject:",all"`
+	}
+	type unsettable struct {
+		checkers []healthChecker `inject:",all"`
+	}
+	type prefilled struct {
+		Checkers []healthChecker `inject:",all"`
+	}
+
+	inj := NewInjector()
+	inj.Add(NewKey("a"), &checker{Name: "a"})
+	given := &prefilled{Checkers: []healthChecker{}}
+
+	// -- When
+	//
+	notSliceErr := inj.Inject(new(notSlice))
+	unsettableErr := inj.Inject(new(unsettable))
+	prefilledErr := inj.Inject(given)
+
+	// -- Then
+	//
+	i.ErrorIs(notSliceErr, ErrInvalidField)
+	i.EqualError(notSliceErr, "invalid field: field Checker must be a slice to inject all values but got type axon.healthChecker")
+	i.EqualError(unsettableErr, "invalid field: field checkers is not settable")
+	i.NoError(prefilledErr)
+	i.Empty(given.Checkers)
+}
+
+func (i *InjectorTestSuite) TestInjectAllError() {
+	// -- Given
+	//
+	type server struct {
+		Checkers []healthChecker `inject:",all"`
+	}
+
+	inj := NewInjector()
+	inj.Add(NewKey("a"), NewFactory[healthChecker](func(_ Injector) (healthChecker, error) {
+		return nil, errors.New("unhealthy")
+	}))
+
+	// -- When
+	//
+	err := inj.Inject(new(server))
+
+	// -- Then
+	//
+	i.EqualError(err, "failed to get field a: unhealthy")
+}
+
 func (i *InjectorTestSuite) TestAddStruct() {
 	// -- Given
 	//
@@ -353,7 +595,7 @@ func (i *InjectorTestSuite) TestInjectWrongType() {
 	}
 
 	inj := NewInjector()
-	inj.Add(NewKey("i"), "1")
+	inj.Add(NewKey("i"), true)
 
 	// -- When
 	//
@@ -361,7 +603,7 @@ func (i *InjectorTestSuite) TestInjectWrongType() {
 
 	// -- Then
 	//
-	i.EqualError(err, "invalid type: field i is type int but got type string")
+	i.EqualError(err, "invalid type: field i is type int but got type bool")
 }
 
 func (i *InjectorTestSuite) TestInjectSkipErr() {
@@ -373,7 +615,7 @@ func (i *InjectorTestSuite) TestInjectSkipErr() {
 	}
 
 	inj := NewInjector()
-	inj.Add(NewKey("i"), "1")
+	inj.Add(NewKey("i"), true)
 	inj.Add(NewTypeKey[string]("str"))
 	expected := &test{
 		S: "str",
@@ -391,6 +633,138 @@ func (i *InjectorTestSuite) TestInjectSkipErr() {
 	}
 }
 
+type allErrsConfig struct {
+	I     int      `inject:"i"`
+	S     string   `inject:",type"`
+	M     string   `inject:"missing"`
+	All   int      `inject:",all"`
+	Names []string `inject:"names"`
+}
+
+func (i *InjectorTestSuite) TestInjectAllFieldErrs() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("i"), true)
+	inj.Add(NewTypeKey[string]("str"))
+	inj.Add(NewKey("names"), []string{"a"})
+	actual := new(allErrsConfig)
+
+	// -- When
+	//
+	err := inj.Inject(actual, WithAllFieldErrs(), WithSkipFieldErrs())
+
+	// -- Then
+	//
+	i.Equal(&allErrsConfig{S: "str", Names: []string{"a"}}, actual)
+	i.ErrorIs(err, ErrInvalidType)
+	i.ErrorIs(err, ErrNotFound)
+
+	var joined interface{ Unwrap() []error }
+	if i.True(errors.As(err, &joined)) && i.Len(joined.Unwrap(), 3) {
+		errs := joined.Unwrap()
+		i.EqualError(errs[0], "field allErrsConfig.I (key i): invalid type: field i is type int but got type bool")
+		i.EqualError(errs[1], "field allErrsConfig.M (key missing): failed to inject missing: not found")
+		i.EqualError(errs[2], "field allErrsConfig.All: invalid field: field All must be a slice to inject all values but got type int")
+
+		var fieldErr *FieldError
+		if i.True(errors.As(errs[1], &fieldErr)) {
+			i.Equal("allErrsConfig.M", fieldErr.Field)
+			i.Equal(NewKey("missing"), fieldErr.Key)
+			i.ErrorIs(fieldErr, ErrNotFound)
+		}
+	}
+}
+
+func (i *InjectorTestSuite) TestInjectAllFieldErrsMethod() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(newTypeKey[*methodDB](), &methodDB{Name: "postgres"})
+	inj.Add(newTypeKey[int](), 8080)
+	actual := &struct {
+		S string `inject:"s"`
+		methodServer
+	}{}
+
+	// -- When
+	//
+	err := inj.Inject(actual, WithAllFieldErrs(), WithInjectSetters("SetTags"))
+
+	// -- Then
+	//
+	var fieldErr *FieldError
+	if i.True(errors.As(err, &fieldErr)) {
+		i.Equal("S", fieldErr.Field)
+	}
+	i.NotContains(err.Error(), "InjectDependencies")
+	i.Zero(actual.calls)
+	i.Nil(actual.db)
+}
+
+func (i *InjectorTestSuite) TestInjectAllFieldErrsMethodError() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("s"), "s")
+	actual := &struct {
+		S string `inject:"s"`
+		methodServer
+	}{}
+
+	// -- When
+	//
+	err := inj.Inject(actual, WithAllFieldErrs())
+
+	// -- Then
+	//
+	i.Equal("s", actual.S)
+	i.ErrorContains(err, "failed to call method InjectDependencies")
+	i.False(errors.As(err, new(*FieldError)))
+}
+
+func (i *InjectorTestSuite) TestInjectSkipFieldErrsMethod() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(newTypeKey[*methodDB](), &methodDB{Name: "postgres"})
+	inj.Add(newTypeKey[int](), 8080)
+	actual := &struct {
+		S string `inject:"s"`
+		methodServer
+	}{}
+
+	// -- When
+	//
+	err := inj.Inject(actual, WithSkipFieldErrs(), WithInjectSetters("SetTags"))
+
+	// -- Then
+	//
+	i.NoError(err)
+	i.Zero(actual.calls)
+	i.Nil(actual.db)
+}
+
+func (i *InjectorTestSuite) TestInjectAllFieldErrsNone() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("s"), "s")
+	actual := &struct {
+		S string `inject:"s"`
+	}{}
+
+	// -- When
+	//
+	err := inj.Inject(actual, WithAllFieldErrs())
+
+	// -- Then
+	//
+	if i.NoError(err) {
+		i.Equal("s", actual.S)
+	}
+}
+
 func (i *InjectorTestSuite) TestNonStructMutableValue() {
 	// -- Given
 	//
@@ -4,20 +4,53 @@ import "github.com/eddieowens/axon/maps"
 
 type MutableRangeFunc[K any, V any] func(key K, val V, p DepMap[K, V]) bool
 
+// DoubleMap a map of values along with the dependencies between their keys. Methods which only read the DoubleMap never
+// modify it so they can be called concurrently with one another but not with any method which writes to it.
 type DoubleMap[K any, V any] interface {
 	DepMap[K, V]
 
-	// GetDependencies recursively searches for all values that the key depends on.
+	// GetDependencies returns the keys that the key directly depends on. See GetTransitiveDependencies for all keys the
+	// key depends on.
 	GetDependencies(key K) []K
 
-	// GetDependents recursively searches for all values that depend on a key.
+	// GetDependents returns the keys that directly depend on the key. See GetTransitiveDependents for all keys that depend
+	// on the key.
 	GetDependents(key K) []K
 
+	// GetTransitiveDependencies recursively searches for all keys that the key depends on either directly or through
+	// other keys. Keys closer to the key are returned first. The key itself is only returned if it's part of a cycle.
+	GetTransitiveDependencies(key K) []K
+
+	// GetTransitiveDependents recursively searches for all keys that depend on the key either directly or through other
+	// keys. Keys closer to the key are returned first. The key itself is only returned if it's part of a cycle.
+	GetTransitiveDependents(key K) []K
+
+	// FindPath returns the shortest chain of dependencies leading from one key to another e.g. [from, a, b, to] means
+	// that from depends on a, a depends on b, and b depends on to. If from does not depend on to, nil is returned.
+	FindPath(from, to K) []K
+
+	// TopologicalSort returns every key such that each key comes after all the keys it depends on. Keys that don't
+	// depend on one another are returned in the order they were added. If the graph contains a cycle, a *CycleError is
+	// returned.
+	TopologicalSort() ([]K, error)
+
+	// StronglyConnectedComponents returns the groups of keys that all transitively depend on one another. Every key
+	// is within exactly one group.
+	StronglyConnectedComponents() [][]K
+
+	// Cycles returns every group of keys that transitively depend on one another. This includes keys that depend on
+	// themselves.
+	Cycles() [][]K
+
 	RangeDependencies(key K, r MutableRangeFunc[K, V])
 
 	RangeDependents(key K, r MutableRangeFunc[K, V])
 
 	RemoveDependencies(key K)
+
+	// Clone returns a copy of the DoubleMap. Changes made to the copy do not affect the original and vice versa. Values
+	// are copied as is.
+	Clone() DoubleMap[K, V]
 }
 
 type DepMap[K any, V any] interface {
@@ -26,12 +59,13 @@ type DepMap[K any, V any] interface {
 }
 
 // NewDoubleMap creates a DoubleMap comprised of two maps rather than a directed graph. This DoubleMap provides constant time lookups
-// but more limited searching capabilities.
+// but more limited searching capabilities. Keys, dependencies, and dependents are always ranged over in the order they were
+// added.
 func NewDoubleMap[V any]() DoubleMap[any, V] {
 	return &doubleMap[V]{
 		Dependents:   map[any]Set[any]{},
 		Dependencies: map[any]Set[any]{},
-		Vals:         map[any]V{},
+		Vals:         NewOrderedMap[any, V](),
 	}
 }
 
@@ -41,16 +75,28 @@ type doubleMap[V any] struct {
 
 	// Keys that depend upon a set of keys.
 	Dependencies map[any]Set[any]
-	Vals         map[any]V
+
+	// The values in the order they were added so that ranging over the DoubleMap is deterministic.
+	Vals OrderedMap[any, V]
 }
 
-func (m *doubleMap[V]) Find(r maps.FindFunc[any, V]) (v V) {
-	for k, v := range m.Vals {
-		if r(k, v) {
-			return v
-		}
+func (m *doubleMap[V]) Clone() DoubleMap[any, V] {
+	out := &doubleMap[V]{
+		Dependents:   cloneSets(m.Dependents),
+		Dependencies: cloneSets(m.Dependencies),
+		Vals:         NewOrderedMap[any, V](),
 	}
-	return
+
+	m.Vals.Range(func(key any, val V) bool {
+		out.Vals.Add(key, val)
+		return true
+	})
+
+	return out
+}
+
+func (m *doubleMap[V]) Find(r maps.FindFunc[any, V]) (v V) {
+	return m.Vals.Find(r)
 }
 
 func (m *doubleMap[V]) RemoveDependencies(key any) {
@@ -61,14 +107,14 @@ func (m *doubleMap[V]) RemoveDependencies(key any) {
 }
 
 func (m *doubleMap[V]) AddDependencies(key any, keys ...any) {
-	_, exists := m.Vals[key]
+	_, exists := m.Vals.Lookup(key)
 	if !exists {
 		return
 	}
 
 	dependencies := getOrInit(m.Dependencies, key)
 	for _, valKey := range keys {
-		_, exists := m.Vals[valKey]
+		_, exists := m.Vals.Lookup(valKey)
 		if !exists {
 			continue
 		}
@@ -80,19 +126,12 @@ func (m *doubleMap[V]) AddDependencies(key any, keys ...any) {
 }
 
 func (m *doubleMap[V]) Range(r maps.RangeFunc[any, V]) {
-	for k, v := range m.Vals {
-		ok := r(k, v)
-		if !ok {
-			return
-		}
-	}
+	m.Vals.Range(r)
 }
 
 func (m *doubleMap[V]) RangeDependencies(key any, r MutableRangeFunc[any, V]) {
-	deps := getOrInit(m.Dependencies, key)
-
-	for _, valKey := range deps.GetAll() {
-		ok := r(valKey, m.Vals[valKey], m)
+	for _, valKey := range neighbours(m.Dependencies, key) {
+		ok := r(valKey, m.Vals.Get(valKey), m)
 		if !ok {
 			return
 		}
@@ -100,9 +139,8 @@ func (m *doubleMap[V]) RangeDependencies(key any, r MutableRangeFunc[any, V]) {
 }
 
 func (m *doubleMap[V]) RangeDependents(key any, r MutableRangeFunc[any, V]) {
-	deps := getOrInit(m.Dependents, key)
-	for _, valKey := range deps.GetAll() {
-		ok := r(valKey, m.Vals[valKey], m)
+	for _, valKey := range neighbours(m.Dependents, key) {
+		ok := r(valKey, m.Vals.Get(valKey), m)
 		if !ok {
 			return
 		}
@@ -110,26 +148,36 @@ func (m *doubleMap[V]) RangeDependents(key any, r MutableRangeFunc[any, V]) {
 }
 
 func (m *doubleMap[V]) GetDependencies(key any) []any {
-	d := getOrInit(m.Dependencies, key)
-	return d.GetAll()
+	return neighbours(m.Dependencies, key)
 }
 
 func (m *doubleMap[V]) GetDependents(key any) []any {
-	d := getOrInit(m.Dependents, key)
-	return d.GetAll()
+	return neighbours(m.Dependents, key)
 }
 
 func (m *doubleMap[V]) Remove(key any) {
-	for _, v := range m.Dependents {
-		v.Remove(key)
+	// unlink the key from both sides of every edge it's part of so it's not returned for any other key.
+	if dependents := m.Dependents[key]; dependents != nil {
+		for _, dependent := range dependents.GetAll() {
+			if deps := m.Dependencies[dependent]; deps != nil {
+				deps.Remove(key)
+			}
+		}
+	}
+	if dependencies := m.Dependencies[key]; dependencies != nil {
+		for _, dep := range dependencies.GetAll() {
+			if deps := m.Dependents[dep]; deps != nil {
+				deps.Remove(key)
+			}
+		}
 	}
 	delete(m.Dependents, key)
 	delete(m.Dependencies, key)
-	delete(m.Vals, key)
+	m.Vals.Remove(key)
 }
 
 func (m *doubleMap[V]) Add(key any, val V) {
-	m.Vals[key] = val
+	m.Vals.Add(key, val)
 }
 
 func getOrInit(ma map[any]Set[any], key any) Set[any] {
@@ -141,11 +189,22 @@ func getOrInit(ma map[any]Set[any], key any) Set[any] {
 	return out
 }
 
+func cloneSets(ma map[any]Set[any]) map[any]Set[any] {
+	out := make(map[any]Set[any], len(ma))
+	for k, v := range ma {
+		set := NewSet[any]()
+		for _, item := range v.GetAll() {
+			set.Add(item)
+		}
+		out[k] = set
+	}
+	return out
+}
+
 func (m *doubleMap[V]) Get(key any) V {
-	return m.Vals[key]
+	return m.Vals.Get(key)
 }
 
 func (m *doubleMap[V]) Lookup(key any) (V, bool) {
-	val, ok := m.Vals[key]
-	return val, ok
+	return m.Vals.Lookup(key)
 }
@@ -176,6 +176,48 @@ func (d *DoubleMapTestSuite) TestRemove() {
 	d.Empty(given.GetDependents("1"))
 }
 
+func (d *DoubleMapTestSuite) TestRemoveUnlinks() {
+	// -- Given
+	//
+	given := newChain()
+
+	// -- When
+	//
+	given.Remove("c")
+
+	// -- Then
+	//
+	d.Equal([]any{"b"}, given.GetDependencies("a"))
+	d.Empty(given.GetDependencies("b"))
+	d.Empty(given.GetDependents("d"))
+	d.Equal([]any{"b"}, given.GetTransitiveDependencies("a"))
+	d.Empty(given.GetTransitiveDependents("d"))
+}
+
+func (d *DoubleMapTestSuite) TestReadsDontWrite() {
+	// -- Given
+	//
+	given := NewDoubleMap[int]()
+	given.Add("1", 1)
+	noop := func(_ any, _ int, _ DepMap[any, int]) bool {
+		return true
+	}
+
+	// -- When
+	//
+	dependencies := given.GetDependencies("1")
+	dependents := given.GetDependents("missing")
+	given.RangeDependencies("1", noop)
+	given.RangeDependents("missing", noop)
+
+	// -- Then
+	//
+	d.Empty(dependencies)
+	d.Empty(dependents)
+	d.Empty(given.(*doubleMap[int]).Dependencies)
+	d.Empty(given.(*doubleMap[int]).Dependents)
+}
+
 func (d *DoubleMapTestSuite) TestFind() {
 	// -- Given
 	//
@@ -210,6 +252,31 @@ func (d *DoubleMapTestSuite) TestLookup() {
 	d.True(ok)
 }
 
+func (d *DoubleMapTestSuite) TestClone() {
+	// -- Given
+	//
+	given := NewDoubleMap[int]()
+	given.Add("1", 1)
+	given.Add("2", 2)
+	given.AddDependencies("1", "2")
+
+	// -- When
+	//
+	actual := given.Clone()
+	given.Add("1", 3)
+	given.RemoveDependencies("1")
+	actual.Add("3", 3)
+
+	// -- Then
+	//
+	d.Equal(1, actual.Get("1"))
+	d.Equal([]any{"2"}, actual.GetDependencies("1"))
+	d.Equal([]any{"1"}, actual.GetDependents("2"))
+	d.Empty(given.GetDependencies("1"))
+	_, ok := given.Lookup("3")
+	d.False(ok)
+}
+
 func TestDepGraphTestSuite(t *testing.T) {
 	suite.Run(t, new(DoubleMapTestSuite))
 }
@@ -0,0 +1,78 @@
+package depgraph
+
+import "github.com/eddieowens/axon/maps"
+
+// OrderedMap a maps.Map which ranges over its keys in the order they were first added. Adding a key that already exists
+// updates its value without changing its position.
+type OrderedMap[K any, V any] interface {
+	maps.Map[K, V]
+
+	// Keys returns every key in insertion order.
+	Keys() []K
+
+	Len() int
+}
+
+func NewOrderedMap[K any, V any]() OrderedMap[K, V] {
+	return &orderedMap[K, V]{
+		Order: newSet[K](),
+		Vals:  map[any]V{},
+	}
+}
+
+type orderedMap[K any, V any] struct {
+	Order *set[K]
+	Vals  map[any]V
+}
+
+func (o *orderedMap[K, V]) Remove(key K) {
+	o.Order.Remove(key)
+	delete(o.Vals, key)
+}
+
+func (o *orderedMap[K, V]) Add(key K, val V) {
+	o.Order.Add(key)
+	o.Vals[key] = val
+}
+
+func (o *orderedMap[K, V]) Get(key K) V {
+	return o.Vals[key]
+}
+
+func (o *orderedMap[K, V]) Lookup(key K) (V, bool) {
+	v, ok := o.Vals[key]
+	return v, ok
+}
+
+// Range calls r for every key in insertion order. Keys added during the Range are not visited and keys removed during
+// the Range are skipped.
+func (o *orderedMap[K, V]) Range(r maps.RangeFunc[K, V]) {
+	for _, k := range o.Order.GetAll() {
+		v, ok := o.Vals[k]
+		if !ok {
+			continue
+		}
+		if !r(k, v) {
+			return
+		}
+	}
+}
+
+func (o *orderedMap[K, V]) Find(r maps.FindFunc[K, V]) (v V) {
+	o.Range(func(key K, val V) bool {
+		if r(key, val) {
+			v = val
+			return false
+		}
+		return true
+	})
+	return
+}
+
+func (o *orderedMap[K, V]) Keys() []K {
+	return o.Order.GetAll()
+}
+
+func (o *orderedMap[K, V]) Len() int {
+	return o.Order.Len()
+}
@@ -0,0 +1,130 @@
+package depgraph
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type OrderedMapTestSuite struct {
+	suite.Suite
+}
+
+func (o *OrderedMapTestSuite) TestRange() {
+	// -- Given
+	//
+	given := NewOrderedMap[string, int]()
+	given.Add("c", 1)
+	given.Add("a", 2)
+	given.Add("b", 3)
+	given.Add("c", 4)
+
+	keys := make([]string, 0)
+	vals := make([]int, 0)
+
+	// -- When
+	//
+	given.Range(func(key string, val int) bool {
+		keys = append(keys, key)
+		vals = append(vals, val)
+		return true
+	})
+
+	// -- Then
+	//
+	o.Equal([]string{"c", "a", "b"}, keys)
+	o.Equal([]int{4, 2, 3}, vals)
+	o.Equal(keys, given.Keys())
+	o.Equal(3, given.Len())
+}
+
+func (o *OrderedMapTestSuite) TestRangeMutate() {
+	// -- Given
+	//
+	given := NewOrderedMap[string, int]()
+	given.Add("a", 1)
+	given.Add("b", 2)
+	given.Add("c", 3)
+
+	keys := make([]string, 0)
+
+	// -- When
+	//
+	given.Range(func(key string, val int) bool {
+		keys = append(keys, key)
+		given.Remove("b")
+		given.Add("d", 4)
+		return true
+	})
+
+	// -- Then
+	//
+	o.Equal([]string{"a", "c"}, keys)
+	o.Equal([]string{"a", "c", "d"}, given.Keys())
+}
+
+func (o *OrderedMapTestSuite) TestRangeStop() {
+	// -- Given
+	//
+	given := NewOrderedMap[string, int]()
+	given.Add("a", 1)
+	given.Add("b", 2)
+
+	count := 0
+
+	// -- When
+	//
+	given.Range(func(key string, val int) bool {
+		count++
+		return false
+	})
+
+	// -- Then
+	//
+	o.Equal(1, count)
+}
+
+func (o *OrderedMapTestSuite) TestFind() {
+	// -- Given
+	//
+	given := NewOrderedMap[string, int]()
+	given.Add("a", 1)
+	given.Add("b", 2)
+	given.Add("c", 3)
+
+	// -- When
+	//
+	actual := given.Find(func(key string, val int) bool {
+		return val > 1
+	})
+	missing := given.Find(func(key string, val int) bool {
+		return false
+	})
+
+	// -- Then
+	//
+	o.Equal(2, actual)
+	o.Zero(missing)
+}
+
+func (o *OrderedMapTestSuite) TestGetAndLookup() {
+	// -- Given
+	//
+	given := NewOrderedMap[string, int]()
+	given.Add("a", 1)
+
+	// -- When
+	//
+	given.Remove("a")
+	actual, ok := given.Lookup("a")
+
+	// -- Then
+	//
+	o.Zero(actual)
+	o.False(ok)
+	o.Zero(given.Get("a"))
+	o.Empty(given.Keys())
+}
+
+func TestOrderedMapTestSuite(t *testing.T) {
+	suite.Run(t, new(OrderedMapTestSuite))
+}
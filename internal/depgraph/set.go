@@ -1,35 +1,65 @@
 package depgraph
 
+// Set a collection of unique keys. GetAll returns the keys in the order they were first added.
 type Set[K any] interface {
 	Add(key K)
 	Remove(key K)
 	GetAll() []K
+	Has(key K) bool
+	Len() int
 }
 
 func NewSet[K any]() Set[K] {
+	return newSet[K]()
+}
+
+func newSet[K any]() *set[K] {
 	return &set[K]{
-		Map: map[any]bool{},
+		Keys:  make([]K, 0),
+		Index: map[any]int{},
 	}
 }
 
 type set[K any] struct {
-	Map map[any]bool
+	// The keys in insertion order.
+	Keys []K
+
+	// The position of each key within Keys.
+	Index map[any]int
 }
 
 func (s *set[K]) GetAll() []K {
-	out := make([]K, len(s.Map))
-	i := 0
-	for k := range s.Map {
-		out[i] = k.(K)
-		i++
-	}
+	out := make([]K, len(s.Keys))
+	copy(out, s.Keys)
 	return out
 }
 
 func (s *set[K]) Add(key K) {
-	s.Map[key] = true
+	if _, ok := s.Index[key]; ok {
+		return
+	}
+	s.Index[key] = len(s.Keys)
+	s.Keys = append(s.Keys, key)
 }
 
 func (s *set[K]) Remove(key K) {
-	delete(s.Map, key)
+	i, ok := s.Index[key]
+	if !ok {
+		return
+	}
+
+	delete(s.Index, key)
+	s.Keys = append(s.Keys[:i], s.Keys[i+1:]...)
+	for j := i; j < len(s.Keys); j++ {
+		s.Index[s.Keys[j]] = j
+	}
+}
+
+func (s *set[K]) Has(key K) bool {
+	_, ok := s.Index[key]
+	return ok
+}
+
+func (s *set[K]) Len() int {
+	return len(s.Keys)
 }
@@ -0,0 +1,71 @@
+package depgraph
+
+import (
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type SetTestSuite struct {
+	suite.Suite
+}
+
+func (s *SetTestSuite) TestInsertionOrder() {
+	// -- Given
+	//
+	given := NewSet[string]()
+
+	// -- When
+	//
+	given.Add("c")
+	given.Add("a")
+	given.Add("b")
+	given.Add("c")
+
+	// -- Then
+	//
+	s.Equal([]string{"c", "a", "b"}, given.GetAll())
+	s.Equal(3, given.Len())
+	s.True(given.Has("a"))
+}
+
+func (s *SetTestSuite) TestRemove() {
+	// -- Given
+	//
+	given := NewSet[string]()
+	given.Add("c")
+	given.Add("a")
+	given.Add("b")
+
+	// -- When
+	//
+	given.Remove("a")
+	given.Remove("missing")
+	given.Add("a")
+
+	// -- Then
+	//
+	s.Equal([]string{"c", "b", "a"}, given.GetAll())
+	given.Remove("c")
+	s.Equal([]string{"b", "a"}, given.GetAll())
+	s.False(given.Has("c"))
+}
+
+func (s *SetTestSuite) TestGetAllCopy() {
+	// -- Given
+	//
+	given := NewSet[string]()
+	given.Add("a")
+
+	// -- When
+	//
+	actual := given.GetAll()
+	actual[0] = "b"
+
+	// -- Then
+	//
+	s.Equal([]string{"a"}, given.GetAll())
+}
+
+func TestSetTestSuite(t *testing.T) {
+	suite.Run(t, new(SetTestSuite))
+}
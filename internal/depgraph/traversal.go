@@ -0,0 +1,224 @@
+package depgraph
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrCycle returned when an operation can't be completed because the graph contains a cycle. See CycleError.
+var ErrCycle = errors.New("cycle")
+
+// CycleError the cycles found within a DoubleMap.
+type CycleError[K any] struct {
+	Cycles [][]K
+}
+
+func (c *CycleError[K]) Error() string {
+	cycles := make([]string, len(c.Cycles))
+	for i, cycle := range c.Cycles {
+		keys := make([]string, len(cycle))
+		for j, k := range cycle {
+			keys[j] = fmt.Sprintf("%v", k)
+		}
+		cycles[i] = "[" + strings.Join(keys, ", ") + "]"
+	}
+	return fmt.Sprintf("%s: %s", ErrCycle.Error(), strings.Join(cycles, ", "))
+}
+
+func (c *CycleError[K]) Unwrap() error {
+	return ErrCycle
+}
+
+func (m *doubleMap[V]) GetTransitiveDependencies(key any) []any {
+	return m.walk(m.Dependencies, key)
+}
+
+func (m *doubleMap[V]) GetTransitiveDependents(key any) []any {
+	return m.walk(m.Dependents, key)
+}
+
+func (m *doubleMap[V]) FindPath(from, to any) []any {
+	parents := map[any]any{}
+	queue := []any{from}
+	for len(queue) > 0 {
+		k := queue[0]
+		queue = queue[1:]
+		for _, dep := range neighbours(m.Dependencies, k) {
+			if _, visited := parents[dep]; visited {
+				continue
+			}
+			parents[dep] = k
+
+			if dep == to {
+				path := []any{to}
+				for cur := k; ; cur = parents[cur] {
+					path = append(path, cur)
+					if cur == from {
+						break
+					}
+				}
+				reverse(path)
+				return path
+			}
+			queue = append(queue, dep)
+		}
+	}
+	return nil
+}
+
+func (m *doubleMap[V]) TopologicalSort() ([]any, error) {
+	remaining := make(map[any]int, m.Vals.Len())
+	queue := make([]any, 0)
+	for _, k := range m.Vals.Keys() {
+		count := 0
+		for _, dep := range neighbours(m.Dependencies, k) {
+			if _, ok := m.Vals.Lookup(dep); ok {
+				count++
+			}
+		}
+		remaining[k] = count
+		if count == 0 {
+			queue = append(queue, k)
+		}
+	}
+
+	out := make([]any, 0, m.Vals.Len())
+	for len(queue) > 0 {
+		k := queue[0]
+		queue = queue[1:]
+		out = append(out, k)
+		for _, dependent := range neighbours(m.Dependents, k) {
+			if _, ok := remaining[dependent]; !ok {
+				continue
+			}
+			remaining[dependent]--
+			if remaining[dependent] == 0 {
+				queue = append(queue, dependent)
+			}
+		}
+	}
+
+	if len(out) != m.Vals.Len() {
+		return nil, &CycleError[any]{Cycles: m.Cycles()}
+	}
+	return out, nil
+}
+
+func (m *doubleMap[V]) StronglyConnectedComponents() [][]any {
+	t := &tarjan[V]{
+		graph:   m,
+		index:   map[any]int{},
+		low:     map[any]int{},
+		onStack: map[any]bool{},
+	}
+
+	for _, k := range m.Vals.Keys() {
+		if _, visited := t.index[k]; !visited {
+			t.connect(k)
+		}
+	}
+	return t.components
+}
+
+func (m *doubleMap[V]) Cycles() [][]any {
+	out := make([][]any, 0)
+	for _, c := range m.StronglyConnectedComponents() {
+		if len(c) > 1 || m.dependsOnSelf(c[0]) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func (m *doubleMap[V]) dependsOnSelf(key any) bool {
+	for _, dep := range neighbours(m.Dependencies, key) {
+		if dep == key {
+			return true
+		}
+	}
+	return false
+}
+
+// walk does a breadth-first search of the graph starting from the key but excluding the key unless it's reachable from
+// itself.
+func (m *doubleMap[V]) walk(edges map[any]Set[any], key any) []any {
+	out := make([]any, 0)
+	visited := map[any]bool{}
+	queue := neighbours(edges, key)
+	for len(queue) > 0 {
+		k := queue[0]
+		queue = queue[1:]
+		if visited[k] {
+			continue
+		}
+		visited[k] = true
+		out = append(out, k)
+		queue = append(queue, neighbours(edges, k)...)
+	}
+	return out
+}
+
+// tarjan Tarjan's strongly connected components algorithm.
+type tarjan[V any] struct {
+	graph      *doubleMap[V]
+	counter    int
+	index      map[any]int
+	low        map[any]int
+	stack      []any
+	onStack    map[any]bool
+	components [][]any
+}
+
+func (t *tarjan[V]) connect(key any) {
+	t.index[key] = t.counter
+	t.low[key] = t.counter
+	t.counter++
+	t.stack = append(t.stack, key)
+	t.onStack[key] = true
+
+	for _, dep := range neighbours(t.graph.Dependencies, key) {
+		if _, ok := t.graph.Vals.Lookup(dep); !ok {
+			continue
+		}
+
+		if _, visited := t.index[dep]; !visited {
+			t.connect(dep)
+			t.low[key] = min(t.low[key], t.low[dep])
+		} else if t.onStack[dep] {
+			t.low[key] = min(t.low[key], t.index[dep])
+		}
+	}
+
+	if t.low[key] != t.index[key] {
+		return
+	}
+
+	component := make([]any, 0)
+	for {
+		k := t.stack[len(t.stack)-1]
+		t.stack = t.stack[:len(t.stack)-1]
+		t.onStack[k] = false
+		component = append(component, k)
+		if k == key {
+			break
+		}
+	}
+	reverse(component)
+	t.components = append(t.components, component)
+}
+
+// neighbours returns the keys adjacent to the key without initializing an entry for it.
+func neighbours(edges map[any]Set[any], key any) []any {
+	s, ok := edges[key]
+	if !ok {
+		return nil
+	}
+	return s.GetAll()
+}
+
+func reverse[K any](s []K) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
@@ -0,0 +1,195 @@
+package depgraph
+
+import (
+	"errors"
+)
+
+// newChain creates the graph a -> b -> c -> d along with a -> c and e with no dependencies.
+func newChain() DoubleMap[any, int] {
+	given := NewDoubleMap[int]()
+	for i, k := range []string{"a", "b", "c", "d", "e"} {
+		given.Add(k, i)
+	}
+	given.AddDependencies("a", "b", "c")
+	given.AddDependencies("b", "c")
+	given.AddDependencies("c", "d")
+	return given
+}
+
+func (d *DoubleMapTestSuite) TestGetTransitiveDependencies() {
+	// -- Given
+	//
+	given := newChain()
+
+	// -- When
+	//
+	actual := given.GetTransitiveDependencies("a")
+
+	// -- Then
+	//
+	d.Equal([]any{"b", "c", "d"}, actual)
+	d.Empty(given.GetTransitiveDependencies("d"))
+	d.Empty(given.GetTransitiveDependencies("missing"))
+}
+
+func (d *DoubleMapTestSuite) TestGetTransitiveDependents() {
+	// -- Given
+	//
+	given := newChain()
+
+	// -- When
+	//
+	actual := given.GetTransitiveDependents("d")
+
+	// -- Then
+	//
+	d.Equal([]any{"c", "a", "b"}, actual)
+	d.Empty(given.GetTransitiveDependents("a"))
+}
+
+func (d *DoubleMapTestSuite) TestGetTransitiveCycle() {
+	// -- Given
+	//
+	given := newChain()
+
+	// -- When
+	//
+	given.AddDependencies("d", "a")
+
+	// -- Then
+	//
+	d.ElementsMatch([]any{"a", "b", "c", "d"}, given.GetTransitiveDependencies("a"))
+	d.ElementsMatch([]any{"a", "b", "c", "d"}, given.GetTransitiveDependents("a"))
+}
+
+func (d *DoubleMapTestSuite) TestFindPath() {
+	// -- Given
+	//
+	given := newChain()
+
+	// -- When
+	//
+	actual := given.FindPath("a", "d")
+
+	// -- Then
+	//
+	d.Equal([]any{"a", "c", "d"}, actual)
+	d.Equal([]any{"b", "c"}, given.FindPath("b", "c"))
+	d.Nil(given.FindPath("d", "a"))
+	d.Nil(given.FindPath("a", "e"))
+	d.Nil(given.FindPath("a", "a"))
+}
+
+func (d *DoubleMapTestSuite) TestFindPathCycle() {
+	// -- Given
+	//
+	given := newChain()
+	given.AddDependencies("d", "a")
+	given.AddDependencies("e", "e")
+
+	// -- When
+	//
+	actual := given.FindPath("a", "a")
+
+	// -- Then
+	//
+	d.Equal([]any{"a", "c", "d", "a"}, actual)
+	d.Equal([]any{"e", "e"}, given.FindPath("e", "e"))
+}
+
+func (d *DoubleMapTestSuite) TestTopologicalSort() {
+	// -- Given
+	//
+	given := newChain()
+
+	// -- When
+	//
+	actual, err := given.TopologicalSort()
+
+	// -- Then
+	//
+	if d.NoError(err) {
+		d.Equal([]any{"d", "e", "c", "b", "a"}, actual)
+	}
+}
+
+func (d *DoubleMapTestSuite) TestTopologicalSortRemoved() {
+	// -- Given
+	//
+	given := newChain()
+
+	// -- When
+	//
+	given.Remove("d")
+	actual, err := given.TopologicalSort()
+
+	// -- Then
+	//
+	if d.NoError(err) {
+		d.Equal([]any{"c", "e", "b", "a"}, actual)
+	}
+}
+
+func (d *DoubleMapTestSuite) TestTopologicalSortCycle() {
+	// -- Given
+	//
+	given := newChain()
+	given.AddDependencies("d", "b")
+
+	// -- When
+	//
+	actual, err := given.TopologicalSort()
+
+	// -- Then
+	//
+	d.Nil(actual)
+	d.ErrorIs(err, ErrCycle)
+	var cycleErr *CycleError[any]
+	if d.True(errors.As(err, &cycleErr)) && d.Len(cycleErr.Cycles, 1) {
+		d.Equal([]any{"b", "c", "d"}, cycleErr.Cycles[0])
+	}
+}
+
+func (d *DoubleMapTestSuite) TestStronglyConnectedComponents() {
+	// -- Given
+	//
+	given := newChain()
+	given.AddDependencies("d", "b")
+
+	// -- When
+	//
+	actual := given.StronglyConnectedComponents()
+
+	// -- Then
+	//
+	d.Equal([][]any{{"b", "c", "d"}, {"a"}, {"e"}}, actual)
+}
+
+func (d *DoubleMapTestSuite) TestCycles() {
+	// -- Given
+	//
+	given := newChain()
+	given.AddDependencies("e", "e")
+
+	// -- When
+	//
+	actual := given.Cycles()
+
+	// -- Then
+	//
+	d.Equal([][]any{{"e"}}, actual)
+}
+
+func (d *DoubleMapTestSuite) TestCycleErrorString() {
+	// -- Given
+	//
+	given := &CycleError[any]{Cycles: [][]any{{"a", "b"}, {"c"}}}
+
+	// -- When
+	//
+	actual := given.Error()
+
+	// -- Then
+	//
+	d.Equal("cycle: [a, b], [c]", actual)
+}
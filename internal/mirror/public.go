@@ -34,7 +34,7 @@ func Set(dst, src reflect.Value) error {
 
 // CanSet returns true if the reflect.Value.Set can be called without panic.
 func CanSet(dst, src reflect.Value) bool {
-	return dst.CanSet() && (dst.Kind() == src.Kind() || (dst.Kind() == reflect.Interface && src.Type().Implements(dst.Type())))
+	return dst.CanSet() && src.IsValid() && src.Type().AssignableTo(dst.Type())
 }
 
 // StripTypePtrs returns the core type underneath an arbitrary number of pointers.
@@ -0,0 +1,163 @@
+package axon
+
+import (
+	"github.com/eddieowens/axon/maps"
+	"reflect"
+	"time"
+)
+
+// Scope the lifetime of a value within the Injector.
+type Scope int
+
+const (
+	// ScopeSingleton the value is constructed once and the same value is returned until it's invalidated.
+	ScopeSingleton Scope = iota
+
+	// ScopeTransient a new value is constructed every time the value is retrieved. Only applies to a Factory. See
+	// WithScope.
+	ScopeTransient
+)
+
+func (s Scope) String() string {
+	switch s {
+	case ScopeSingleton:
+		return "Singleton"
+	case ScopeTransient:
+		return "Transient"
+	}
+	return "Unknown"
+}
+
+// BindingInfo describes a value within the Injector. See Injector.Describe.
+type BindingInfo struct {
+	// The Key the value is indexed by.
+	Key Key
+
+	// The type of the value. For a Factory, this is the type the Factory builds. If the type can't be known until the
+	// value is constructed (e.g. a FactoryFunc) and the value hasn't been constructed, Type is nil.
+	Type reflect.Type
+
+	// True if the value is constructed via a Factory, false if the value was added as is.
+	IsFactory bool
+
+	// True if the value has been constructed, false otherwise.
+	Instantiated bool
+
+	// The lifetime of the value.
+	Scope Scope
+
+	// How long the last successful construction of the value took including the construction of its dependencies. Zero
+	// if the value has never been constructed.
+	ConstructionDuration time.Duration
+
+	// The Keys the value directly depends on.
+	Dependencies []Key
+
+	// The Keys the value depends on either directly or through other values.
+	TransitiveDependencies []Key
+
+	// The Keys that directly depend on the value.
+	Dependents []Key
+
+	// The Keys that depend on the value either directly or through other values.
+	TransitiveDependents []Key
+
+	// See WithDescription.
+	Description string
+
+	// See WithLabels.
+	Labels map[string]string
+
+	// See WithSource.
+	Source string
+
+	// See WithSecret.
+	Secret bool
+}
+
+func (i *injector) Describe(key Key) (BindingInfo, error) {
+	i.Lock.RLock()
+	defer i.Lock.RUnlock()
+
+	v := key.resolve(i.DepGraph)
+	if v == nil {
+		return BindingInfo{}, ErrNotFound
+	}
+
+	return i.describe(key, v), nil
+}
+
+func (i *injector) Keys() []Key {
+	i.Lock.RLock()
+	defer i.Lock.RUnlock()
+
+	out := make([]Key, 0)
+	i.DepGraph.Range(func(key any, _ containerProvider[any]) bool {
+		out = append(out, key.(Key))
+		return true
+	})
+	return out
+}
+
+func (i *injector) Range(r maps.RangeFunc[Key, BindingInfo]) {
+	// every value is described up front so r sees a consistent view of the injector and is free to call back into it.
+	i.Lock.RLock()
+	infos := make([]BindingInfo, 0)
+	i.DepGraph.Range(func(key any, v containerProvider[any]) bool {
+		infos = append(infos, i.describe(key.(Key), v))
+		return true
+	})
+	i.Lock.RUnlock()
+
+	for _, info := range infos {
+		if i.lookup(info.Key) == nil {
+			continue
+		}
+
+		if !r(info.Key, info) {
+			return
+		}
+	}
+}
+
+// describe returns the BindingInfo for the key. The Lock must be held.
+func (i *injector) describe(key Key, v containerProvider[any]) BindingInfo {
+	o := v.GetAddOpts()
+	state := v.GetState()
+	info := BindingInfo{
+		Key:                    key,
+		Type:                   state.Type,
+		IsFactory:              v.IsFactory(),
+		Instantiated:           state.Instantiated,
+		Scope:                  o.Scope,
+		ConstructionDuration:   state.Duration,
+		Dependencies:           toKeys(i.DepGraph.GetDependencies(key)),
+		TransitiveDependencies: toKeys(i.DepGraph.GetTransitiveDependencies(key)),
+		Dependents:             toKeys(i.DepGraph.GetDependents(key)),
+		TransitiveDependents:   toKeys(i.DepGraph.GetTransitiveDependents(key)),
+		Description:            o.Description,
+		Source:                 o.Source,
+		Secret:                 o.Secret,
+	}
+
+	if _, ok := v.GetValue().(paramFactory); !info.IsFactory && !ok {
+		info.Scope = ScopeSingleton
+	}
+
+	if o.Labels != nil {
+		info.Labels = make(map[string]string, len(o.Labels))
+		for k, v := range o.Labels {
+			info.Labels[k] = v
+		}
+	}
+
+	return info
+}
+
+func toKeys(keys []any) []Key {
+	out := make([]Key, len(keys))
+	for i, k := range keys {
+		out[i] = k.(Key)
+	}
+	return out
+}
@@ -0,0 +1,333 @@
+package axon
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type IntrospectTestSuite struct {
+	suite.Suite
+}
+
+func (i *IntrospectTestSuite) TestDescribe() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("db"), "db",
+		WithDescription("the main database"),
+		WithLabels(map[string]string{"owner": "storage"}),
+		WithLabels(map[string]string{"tier": "1"}),
+		WithSource(),
+		WithSecret(),
+	)
+
+	// -- When
+	//
+	actual, err := inj.Describe(NewKey("db"))
+
+	// -- Then
+	//
+	if i.NoError(err) {
+		i.Equal(NewKey("db"), actual.Key)
+		i.Equal("the main database", actual.Description)
+		i.Equal(map[string]string{"owner": "storage", "tier": "1"}, actual.Labels)
+		i.True(strings.HasSuffix(actual.Source, "introspect_test.go:23"), actual.Source)
+		i.True(actual.Secret)
+	}
+}
+
+func (i *IntrospectTestSuite) TestDescribeLabelsCopied() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("db"), "db", WithLabels(map[string]string{"owner": "storage"}))
+	info, _ := inj.Describe(NewKey("db"))
+
+	// -- When
+	//
+	info.Labels["owner"] = "someone else"
+
+	// -- Then
+	//
+	actual, _ := inj.Describe(NewKey("db"))
+	i.Equal(map[string]string{"owner": "storage"}, actual.Labels)
+}
+
+func (i *IntrospectTestSuite) TestDescribeOverwritten() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("db"), "db", WithDescription("old"))
+
+	// -- When
+	//
+	inj.Add(NewKey("db"), "db")
+
+	// -- Then
+	//
+	actual, _ := inj.Describe(NewKey("db"))
+	i.Empty(actual.Description)
+	i.Nil(actual.Labels)
+}
+
+func (i *IntrospectTestSuite) TestDescribeNotFound() {
+	// -- Given
+	//
+	inj := NewInjector()
+
+	// -- When
+	//
+	_, err := inj.Describe(NewKey("db"))
+
+	// -- Then
+	//
+	i.ErrorIs(err, ErrNotFound)
+}
+
+func (i *IntrospectTestSuite) TestDescribeDependencies() {
+	// -- Given
+	//
+	type c struct{}
+	type b struct {
+		C *c `inject:"c"`
+	}
+	type a struct {
+		B *b `inject:"b"`
+	}
+
+	inj := NewInjector()
+	inj.Add(NewKey("a"), new(a))
+	inj.Add(NewKey("b"), new(b))
+	inj.Add(NewKey("c"), new(c))
+	_, err := inj.Get(NewKey("a"))
+	i.Require().NoError(err)
+
+	// -- When
+	//
+	actualA, errA := inj.Describe(NewKey("a"))
+	actualB, errB := inj.Describe(NewKey("b"))
+	actualC, errC := inj.Describe(NewKey("c"))
+
+	// -- Then
+	//
+	i.NoError(errA)
+	i.NoError(errB)
+	i.NoError(errC)
+
+	i.Equal(reflect.TypeOf(new(a)), actualA.Type)
+	i.False(actualA.IsFactory)
+	i.True(actualA.Instantiated)
+	i.Equal(ScopeSingleton, actualA.Scope)
+	i.Equal([]Key{NewKey("b")}, actualA.Dependencies)
+	i.Equal([]Key{NewKey("b"), NewKey("c")}, actualA.TransitiveDependencies)
+	i.Empty(actualA.Dependents)
+	i.Empty(actualA.TransitiveDependents)
+
+	i.Equal([]Key{NewKey("c")}, actualB.Dependencies)
+	i.Equal([]Key{NewKey("a")}, actualB.Dependents)
+
+	i.Empty(actualC.Dependencies)
+	i.Empty(actualC.TransitiveDependencies)
+	i.Equal([]Key{NewKey("b")}, actualC.Dependents)
+	i.Equal([]Key{NewKey("b"), NewKey("a")}, actualC.TransitiveDependents)
+}
+
+func (i *IntrospectTestSuite) TestDescribeFactory() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("fact"), NewFactory[fmt.Stringer](func(_ Injector) (fmt.Stringer, error) {
+		return NewKey("s"), nil
+	}))
+	inj.Add(NewKey("func"), FactoryFunc[int](func(_ Injector) (int, error) {
+		return 1, nil
+	}))
+
+	// -- When
+	//
+	beforeFact, _ := inj.Describe(NewKey("fact"))
+	beforeFunc, _ := inj.Describe(NewKey("func"))
+	_, _ = inj.Get(NewKey("func"))
+	afterFunc, _ := inj.Describe(NewKey("func"))
+
+	// -- Then
+	//
+	i.True(beforeFact.IsFactory)
+	i.False(beforeFact.Instantiated)
+	i.Equal(reflect.TypeOf(new(fmt.Stringer)).Elem(), beforeFact.Type)
+
+	i.True(beforeFunc.IsFactory)
+	i.False(beforeFunc.Instantiated)
+	i.Equal(reflect.TypeOf(1), beforeFunc.Type)
+	i.True(afterFunc.Instantiated)
+	i.Equal(reflect.TypeOf(1), afterFunc.Type)
+}
+
+func (i *IntrospectTestSuite) TestDescribeTypeKey() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewTypeKey[int](1))
+
+	// -- When
+	//
+	actual := inj.Keys()
+
+	// -- Then
+	//
+	if i.Len(actual, 1) {
+		i.True(actual[0].IsTypeKey())
+		i.False(NewKey("int").IsTypeKey())
+	}
+}
+
+func (i *IntrospectTestSuite) TestKeys() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("a"), 1)
+	inj.Add(NewKey("b"), 2)
+
+	// -- When
+	//
+	actual := inj.Keys()
+
+	// -- Then
+	//
+	i.Equal([]Key{NewKey("a"), NewKey("b")}, actual)
+}
+
+func (i *IntrospectTestSuite) TestRange() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("a"), 1, WithDescription("a"))
+	inj.Add(NewKey("b"), 2, WithDescription("b"))
+
+	actual := map[Key]string{}
+	stopped := 0
+
+	// -- When
+	//
+	inj.Range(func(key Key, val BindingInfo) bool {
+		actual[key] = val.Description
+		return true
+	})
+	inj.Range(func(key Key, val BindingInfo) bool {
+		stopped++
+		return false
+	})
+
+	// -- Then
+	//
+	i.Equal(map[Key]string{NewKey("a"): "a", NewKey("b"): "b"}, actual)
+	i.Equal(1, stopped)
+}
+
+func (i *IntrospectTestSuite) TestRangeRemoved() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("a"), 1)
+	inj.Add(NewKey("b"), 2)
+
+	count := 0
+
+	// -- When
+	//
+	inj.Range(func(key Key, val BindingInfo) bool {
+		count++
+		_ = inj.Remove(NewKey("a"))
+		_ = inj.Remove(NewKey("b"))
+		return true
+	})
+
+	// -- Then
+	//
+	i.Equal(1, count)
+}
+
+func (i *IntrospectTestSuite) TestDescribeDuringConstruction() {
+	// -- Given
+	//
+	fact := &blockingFactory{Started: make(chan struct{}), Release: make(chan struct{})}
+	inj := NewInjector()
+	inj.Add(NewKey("slow"), fact)
+
+	errs := make(chan error, 1)
+	go func() {
+		_, err := inj.Get(NewKey("slow"))
+		errs <- err
+	}()
+	<-fact.Started
+
+	// -- When
+	//
+	go close(fact.Release)
+	var described, ranged BindingInfo
+	for !described.Instantiated || !ranged.Instantiated {
+		described, _ = inj.Describe(NewKey("slow"))
+		inj.Range(func(key Key, val BindingInfo) bool {
+			ranged = val
+			return true
+		})
+	}
+
+	// -- Then
+	//
+	i.NoError(<-errs)
+	for _, info := range []BindingInfo{described, ranged} {
+		i.Equal(reflect.TypeOf(""), info.Type)
+		i.Positive(info.ConstructionDuration)
+	}
+}
+
+func (i *IntrospectTestSuite) TestDescribeRemovedDependency() {
+	// -- Given
+	//
+	type b struct{}
+	type a struct {
+		B *b `inject:"b"`
+	}
+
+	inj := NewInjector()
+	inj.Add(NewKey("a"), new(a))
+	inj.Add(NewKey("b"), new(b))
+	_, err := inj.Get(NewKey("a"))
+	i.Require().NoError(err)
+
+	// -- When
+	//
+	i.Require().NoError(inj.Remove(NewKey("b")))
+
+	// -- Then
+	//
+	actual, _ := inj.Describe(NewKey("a"))
+	i.Empty(actual.Dependencies)
+	i.Empty(actual.TransitiveDependencies)
+}
+
+func (i *IntrospectTestSuite) TestScopeString() {
+	i.Equal("Singleton", ScopeSingleton.String())
+	i.Equal("Transient", ScopeTransient.String())
+	i.Equal("Unknown", Scope(-1).String())
+}
+
+// blockingFactory is a Factory whose type isn't known until it's built and which waits for Release before building.
+type blockingFactory struct {
+	Started chan struct{}
+	Release chan struct{}
+}
+
+func (b *blockingFactory) Build(_ Injector) (any, error) {
+	close(b.Started)
+	<-b.Release
+	return "built", nil
+}
+
+func TestIntrospectTestSuite(t *testing.T) {
+	suite.Run(t, new(IntrospectTestSuite))
+}
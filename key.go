@@ -5,7 +5,8 @@ import (
 	"reflect"
 )
 
-// Key the key type for the Injector.
+// Key the key type for the Injector. Two Keys are only equal if their underlying values have the same type and value so
+// e.g. NewKey(1) and NewKey("1") index different values even though they're printed the same.
 type Key struct {
 	val       any
 	isTypeKey bool
@@ -15,25 +16,63 @@ func (k Key) resolve(storage StorageGetter) containerProvider[any] {
 	return storage.Get(k)
 }
 
+// String returns the printed form of the Key's value. If the value implements fmt.Stringer, its String method is used.
 func (k Key) String() string {
 	return fmt.Sprintf("%v", k.val)
 }
 
+// IsTypeKey returns true if the Key was created from a type e.g. via NewTypeKey, false otherwise.
+func (k Key) IsTypeKey() bool {
+	return k.isTypeKey
+}
+
 func (k Key) IsEmpty() bool {
 	return k.val == nil
 }
 
+// KeyConstraint the values supported by NewKey.
 type KeyConstraint interface {
-	string
+	comparable
 }
 
-// NewKey creates a general purpose Key with any KeyConstraint value. Generally used with a string
+// NewKey creates a general purpose Key with any KeyConstraint value. Generally used with a string but any comparable
+// value such as an int, an enum, or a struct can be used
 //
 //    NewKey("my_key")
+//
+//    type ServiceID int
+//    NewKey(ServiceID(1))
+//
+// Only string Keys can be referenced via the InjectTag.
 func NewKey[V KeyConstraint](val V) Key {
 	return Key{val: val}
 }
 
+// TypedKey a named Key that is tied to the type T of its value. TypedKeys index the same value as a Key created via
+// NewKey with the same name so the value can still be injected via the InjectTag.
+//
+//    var DBKey = axon.NewTypedKey[*sql.DB]("db")
+//
+//    err := axon.AddKey(inj, DBKey, db)
+//    db, err := axon.GetKey(inj, DBKey) // db is a *sql.DB
+type TypedKey[T any] struct {
+	key Key
+}
+
+// NewTypedKey creates a TypedKey for the name.
+func NewTypedKey[T any](name string) TypedKey[T] {
+	return TypedKey[T]{key: NewKey(name)}
+}
+
+// Key returns the underlying Key.
+func (t TypedKey[T]) Key() Key {
+	return t.key
+}
+
+func (t TypedKey[T]) String() string {
+	return t.key.String()
+}
+
 // NewTypeKey returns a Key using the type V as well as the passed in value val.
 func NewTypeKey[V any](val V) (Key, V) {
 	return newTypeKey[V](), val
@@ -53,10 +92,19 @@ func NewTypeKeyProvider[V any](val V) (Key, *Provider[V]) {
 func newTypeKey[V any]() Key {
 	return Key{
 		isTypeKey: true,
-		val:       reflect.ValueOf(new(V)).Type().Elem().String(),
+		val:       reflect.TypeOf(new(V)).Elem(),
 	}
 }
 
 func newReflectKey(v reflect.Value) Key {
-	return Key{isTypeKey: true, val: v.Type().String()}
+	return newKeyOfType(v.Type())
+}
+
+func newKeyOfType(typ reflect.Type) Key {
+	return Key{isTypeKey: true, val: typ}
+}
+
+// keyer is implemented by keys which wrap a Key e.g. TypedKey.
+type keyer interface {
+	Key() Key
 }
@@ -0,0 +1,130 @@
+package axon
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// Lazy defers the construction of a dependency until Get is first called. Fields of type Lazy[T] or *Lazy[T] that are
+// tagged with the InjectTag are filled with a handle to the value rather than the value itself. Lazy is safe to use
+// across goroutines, including while the Injector itself is being used.
+//
+//    type Server struct {
+//        Reports *axon.Lazy[ReportGenerator] `inject:"reports"`
+//    }
+//
+//    reports, err := s.Reports.Get() // the reports value is constructed here
+//
+// The Key of a Lazy field is registered as a dependency as soon as the field is injected. Lazy fields of a
+// MutableValue such as a Provider are supported as well.
+type Lazy[T any] struct {
+	lock     sync.Mutex
+	val      T
+	resolved bool
+	resolve  func(dst reflect.Value) error
+}
+
+// Get gets the value, constructing it if needed. If constructing the value fails, the error is returned and the next
+// call to Get tries again.
+func (l *Lazy[T]) Get() (out T, err error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.resolved {
+		return l.val, nil
+	}
+
+	if l.resolve == nil {
+		return out, fmt.Errorf("%w: lazy value of type %T was never injected", ErrNotFound, out)
+	}
+
+	var val T
+	err = l.resolve(reflect.ValueOf(&val).Elem())
+	if err != nil {
+		return out, err
+	}
+
+	l.val = val
+	l.resolved = true
+	return l.val, nil
+}
+
+// MustGet same as Get but panics if an error is encountered.
+func (l *Lazy[T]) MustGet() T {
+	out, err := l.Get()
+	if err != nil {
+		panic(err)
+	}
+	return out
+}
+
+func (l *Lazy[T]) lazyType() reflect.Type {
+	return reflect.TypeOf(new(T)).Elem()
+}
+
+func (l *Lazy[T]) setResolver(resolve func(dst reflect.Value) error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	var zero T
+	l.val = zero
+	l.resolved = false
+	l.resolve = resolve
+}
+
+// lazyField is implemented by *Lazy.
+type lazyField interface {
+	lazyType() reflect.Type
+	setResolver(resolve func(dst reflect.Value) error)
+}
+
+var lazyFieldType = reflect.TypeOf((*lazyField)(nil)).Elem()
+
+// isLazyField returns true if the field is a Lazy or *Lazy.
+func isLazyField(field reflect.Value) bool {
+	return field.Type().Implements(lazyFieldType) || reflect.PointerTo(field.Type()).Implements(lazyFieldType)
+}
+
+// injectLazy points the Lazy field at the Key within the tag.
+func (i *injector) injectLazy(key Key, field reflect.Value, strctField reflect.StructField, tag string) error {
+	parsed := parseTag(tag)
+	if parsed == nil {
+		return nil
+	}
+
+	if !field.CanSet() {
+		return fmt.Errorf("%w: field %s is not settable", ErrInvalidField, strctField.Name)
+	}
+
+	if field.Kind() == reflect.Ptr && field.IsNil() {
+		field.Set(reflect.New(field.Type().Elem()))
+	} else if field.Kind() != reflect.Ptr {
+		field = field.Addr()
+	}
+	lazy := field.Interface().(lazyField)
+
+	var depKey Key
+	if parsed.Name != "" {
+		depKey = NewKey(parsed.Name)
+	} else if parsed.InjectType {
+		depKey = newKeyOfType(lazy.lazyType())
+	} else {
+		return nil
+	}
+
+	if i.lookup(depKey) == nil {
+		return fmt.Errorf("failed to inject %s: %w", depKey.String(), ErrNotFound)
+	}
+
+	lazy.setResolver(func(dst reflect.Value) error {
+		con, err := i.resolveValue(depKey, nil)
+		if err != nil {
+			return err
+		}
+		return i.setReflectVal(dst, con, depKey)
+	})
+
+	i.addDependencies(key, depKey)
+	return nil
+}
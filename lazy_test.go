@@ -0,0 +1,245 @@
+package axon
+
+import (
+	"errors"
+	"github.com/stretchr/testify/suite"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+type LazyTestSuite struct {
+	suite.Suite
+}
+
+type lazyReport struct {
+	Name string
+}
+
+type lazyServer struct {
+	Reports *Lazy[*lazyReport] `inject:"reports"`
+	Typed   Lazy[*lazyReport]  `inject:",type"`
+}
+
+func (l *LazyTestSuite) TestLazy() {
+	// -- Given
+	//
+	builds := int32(0)
+	inj := NewInjector()
+	inj.Add(NewKey("reports"), NewFactory[*lazyReport](func(_ Injector) (*lazyReport, error) {
+		atomic.AddInt32(&builds, 1)
+		return &lazyReport{Name: "reports"}, nil
+	}))
+	inj.Add(newTypeKey[*lazyReport](), &lazyReport{Name: "typed"})
+	inj.Add(NewKey("server"), new(lazyServer))
+
+	// -- When
+	//
+	actual, err := inj.Get(NewKey("server"))
+
+	// -- Then
+	//
+	l.Require().NoError(err)
+	l.EqualValues(0, builds)
+	info, _ := inj.Describe(NewKey("server"))
+	l.ElementsMatch([]Key{NewKey("reports"), newTypeKey[*lazyReport]()}, info.Dependencies)
+
+	srv := actual.(*lazyServer)
+	l.Equal("reports", srv.Reports.MustGet().Name)
+	l.Equal("reports", srv.Reports.MustGet().Name)
+	l.Equal("typed", srv.Typed.MustGet().Name)
+	l.EqualValues(1, builds)
+}
+
+func (l *LazyTestSuite) TestLazyConcurrent() {
+	// -- Given
+	//
+	builds := int32(0)
+	inj := NewInjector()
+	inj.Add(NewKey("reports"), NewFactory[*lazyReport](func(_ Injector) (*lazyReport, error) {
+		atomic.AddInt32(&builds, 1)
+		return &lazyReport{Name: "reports"}, nil
+	}))
+
+	given := &struct {
+		Reports Lazy[*lazyReport] `inject:"reports"`
+	}{}
+	l.Require().NoError(inj.Inject(given))
+
+	// -- When
+	//
+	wg := sync.WaitGroup{}
+	actual := make([]*lazyReport, 10)
+	for j := range actual {
+		wg.Add(1)
+		go func(j int) {
+			defer wg.Done()
+			actual[j] = given.Reports.MustGet()
+		}(j)
+	}
+	wg.Wait()
+
+	// -- Then
+	//
+	l.EqualValues(1, builds)
+	for _, v := range actual {
+		l.Same(actual[0], v)
+	}
+}
+
+func (l *LazyTestSuite) TestLazyConcurrentInjector() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("name"), "reports")
+	for _, k := range []string{"a", "b", "c"} {
+		inj.Add(NewKey(k), NewFactory[*lazyReport](func(inj Injector) (*lazyReport, error) {
+			name, err := inj.Get(NewKey("name"))
+			if err != nil {
+				return nil, err
+			}
+			return &lazyReport{Name: name.(string)}, nil
+		}))
+	}
+
+	given := &struct {
+		A Lazy[*lazyReport] `inject:"a"`
+		B Lazy[*lazyReport] `inject:"b"`
+		C Lazy[*lazyReport] `inject:"c"`
+	}{}
+	l.Require().NoError(inj.Inject(given))
+
+	// -- When
+	//
+	wg := sync.WaitGroup{}
+	actual := make([]*lazyReport, 3)
+	for j, lazy := range []*Lazy[*lazyReport]{&given.A, &given.B, &given.C} {
+		wg.Add(1)
+		go func(j int, lazy *Lazy[*lazyReport]) {
+			defer wg.Done()
+			actual[j] = lazy.MustGet()
+		}(j, lazy)
+	}
+	for j := 0; j < 10; j++ {
+		inj.Add(NewKey(j), j)
+		_, _ = inj.Get(NewKey(j))
+		_, _ = inj.Describe(NewKey("a"))
+	}
+	wg.Wait()
+
+	// -- Then
+	//
+	for _, v := range actual {
+		l.Equal("reports", v.Name)
+	}
+	info, _ := inj.Describe(NewKey("a"))
+	l.Equal([]Key{NewKey("name")}, info.Dependencies)
+}
+
+func (l *LazyTestSuite) TestLazyProvider() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("port"), 8080)
+
+	given := &struct {
+		Port *Lazy[*Provider[int]] `inject:"port"`
+	}{}
+
+	// -- When
+	//
+	err := inj.Inject(given)
+
+	// -- Then
+	//
+	if l.NoError(err) {
+		l.Equal(8080, given.Port.MustGet().Get())
+	}
+}
+
+func (l *LazyTestSuite) TestLazyRetry() {
+	// -- Given
+	//
+	fail := true
+	inj := NewInjector()
+	inj.Add(NewKey("reports"), NewFactory[*lazyReport](func(_ Injector) (*lazyReport, error) {
+		if fail {
+			return nil, errors.New("unavailable")
+		}
+		return &lazyReport{Name: "reports"}, nil
+	}), WithRetryPolicy(RetryPolicy{MaxAttempts: 1}))
+
+	given := &struct {
+		Reports *Lazy[*lazyReport] `inject:"reports"`
+	}{}
+	l.Require().NoError(inj.Inject(given))
+
+	// -- When
+	//
+	_, err := given.Reports.Get()
+	fail = false
+	actual, retryErr := given.Reports.Get()
+
+	// -- Then
+	//
+	l.ErrorContains(err, "unavailable")
+	if l.NoError(retryErr) {
+		l.Equal("reports", actual.Name)
+	}
+}
+
+func (l *LazyTestSuite) TestLazyNotFound() {
+	// -- Given
+	//
+	inj := NewInjector()
+	given := &struct {
+		Reports *Lazy[*lazyReport] `inject:"reports"`
+	}{}
+
+	// -- When
+	//
+	err := inj.Inject(given)
+
+	// -- Then
+	//
+	l.ErrorIs(err, ErrNotFound)
+}
+
+func (l *LazyTestSuite) TestLazyNotInjected() {
+	// -- Given
+	//
+	given := new(Lazy[int])
+
+	// -- When
+	//
+	_, err := given.Get()
+
+	// -- Then
+	//
+	l.ErrorIs(err, ErrNotFound)
+	l.Panics(func() {
+		given.MustGet()
+	})
+}
+
+func (l *LazyTestSuite) TestLazyNotSettable() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("reports"), &lazyReport{})
+	given := &struct {
+		reports *Lazy[*lazyReport] `inject:"reports"`
+	}{}
+
+	// -- When
+	//
+	err := inj.Inject(given)
+
+	// -- Then
+	//
+	l.ErrorIs(err, ErrInvalidField)
+}
+
+func TestLazyTestSuite(t *testing.T) {
+	suite.Run(t, new(LazyTestSuite))
+}
@@ -0,0 +1,18 @@
+package axon
+
+// PostConstruct is implemented by values that need to be initialized once all of their dependencies have been injected.
+// Init is called whenever the Injector constructs the value, after all the fields tagged with the InjectTag are set and
+// before any Decorator is applied. If Init returns an error, the construction of the value fails with that error.
+type PostConstruct interface {
+	Init() error
+}
+
+// PreDestroy is implemented by values that need to release resources before they're discarded by the Injector.
+// Destroy is called on values that were constructed by the Injector via a Factory whenever they are invalidated (e.g.
+// overwritten by Injector.Add), removed via Injector.Remove, or when Injector.Shutdown is called. Values that were added
+// as is are owned by the caller so they're never destroyed by the Injector, e.g. a value that's overridden and later
+// restored via Injector.Restore is still usable. Destroy is called on the value held
+// by the Injector, so if a Decorator wraps a PreDestroy value, the wrapper should implement PreDestroy as well.
+type PreDestroy interface {
+	Destroy() error
+}
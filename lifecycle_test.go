@@ -0,0 +1,260 @@
+package axon
+
+import (
+	"errors"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type LifecycleTestSuite struct {
+	suite.Suite
+}
+
+func (l *LifecycleTestSuite) TestPostConstruct() {
+	// -- Given
+	//
+	var events []string
+	inj := NewInjector()
+	inj.Add(NewKey("S"), "s")
+	inj.Add(NewKey("l"), &lifecycle{Name: "l", Events: &events})
+
+	// -- When
+	//
+	_, err := inj.Get(NewKey("l"))
+	_, _ = inj.Get(NewKey("l"))
+
+	// -- Then
+	//
+	if l.NoError(err) {
+		l.Equal([]string{"init l s"}, events)
+	}
+}
+
+func (l *LifecycleTestSuite) TestPostConstructError() {
+	// -- Given
+	//
+	var events []string
+	inj := NewInjector()
+	inj.Add(NewKey("S"), "s")
+	inj.Add(NewKey("l"), &lifecycle{Name: "l", Events: &events, InitErr: errors.New("init")})
+
+	// -- When
+	//
+	actual, err := inj.Get(NewKey("l"))
+
+	// -- Then
+	//
+	l.EqualError(err, "init")
+	l.Nil(actual)
+}
+
+func (l *LifecycleTestSuite) TestPreDestroyOnAdd() {
+	// -- Given
+	//
+	var events []string
+	inj := NewInjector()
+	inj.Add(NewKey("S"), "s")
+	inj.Add(NewKey("l"), newLifecycle(&lifecycle{Name: "l", Events: &events}))
+	inj.Add(NewKey("unbuilt"), newLifecycle(&lifecycle{Name: "unbuilt", Events: &events}))
+	_, _ = inj.Get(NewKey("l"))
+
+	// -- When
+	//
+	inj.Add(NewKey("l"), newLifecycle(&lifecycle{Name: "l2", Events: &events}))
+	inj.Add(NewKey("unbuilt"), 1)
+
+	// -- Then
+	//
+	l.Equal([]string{"init l s", "destroy l"}, events)
+}
+
+func (l *LifecycleTestSuite) TestRemove() {
+	// -- Given
+	//
+	var events []string
+	inj := NewInjector()
+	inj.Add(NewKey("S"), "s")
+	inj.Add(NewKey("l"), newLifecycle(&lifecycle{Name: "l", Events: &events, DestroyErr: errors.New("destroy")}))
+	_, _ = inj.Get(NewKey("l"))
+
+	// -- When
+	//
+	err := inj.Remove(NewKey("l"))
+
+	// -- Then
+	//
+	l.EqualError(err, "destroy")
+	l.Equal([]string{"init l s", "destroy l"}, events)
+	_, err = inj.Get(NewKey("l"))
+	l.ErrorIs(err, ErrNotFound)
+}
+
+func (l *LifecycleTestSuite) TestRemoveNotFound() {
+	// -- Given
+	//
+	inj := NewInjector()
+
+	// -- When
+	//
+	err := inj.Remove(NewKey("l"))
+
+	// -- Then
+	//
+	l.ErrorIs(err, ErrNotFound)
+}
+
+func (l *LifecycleTestSuite) TestShutdown() {
+	// -- Given
+	//
+	type dependent struct {
+		lifecycle
+		Dep *lifecycle `inject:"dep"`
+	}
+
+	var events []string
+	inj := NewInjector()
+	inj.Add(NewKey("S"), "s")
+	inj.Add(NewKey("dependent"), NewFactory[*dependent](func(_ Injector) (*dependent, error) {
+		return &dependent{lifecycle: lifecycle{Name: "dependent", Events: &events}}, nil
+	}))
+	inj.Add(NewKey("dep"), newLifecycle(&lifecycle{Name: "dep", Events: &events, DestroyErr: errors.New("destroy")}))
+	inj.Add(NewKey("unbuilt"), newLifecycle(&lifecycle{Name: "unbuilt", Events: &events}))
+	_, _ = inj.Get(NewKey("dependent"))
+
+	// -- When
+	//
+	err := inj.Shutdown()
+
+	// -- Then
+	//
+	l.EqualError(err, "failed to destroy dep: destroy")
+	l.Equal([]string{"init dep s", "init dependent ", "destroy dependent", "destroy dep"}, events)
+}
+
+func (l *LifecycleTestSuite) TestConstructAfterShutdown() {
+	// -- Given
+	//
+	var events []string
+	inj := NewInjector()
+	inj.Add(NewKey("S"), "s")
+	inj.Add(NewKey("l"), NewFactory[*lifecycle](func(_ Injector) (*lifecycle, error) {
+		return &lifecycle{Name: "l", Events: &events}, nil
+	}))
+	first, _ := inj.Get(NewKey("l"))
+	_ = inj.Shutdown()
+
+	// -- When
+	//
+	actual, err := inj.Get(NewKey("l"))
+
+	// -- Then
+	//
+	if l.NoError(err) {
+		l.NotSame(first, actual)
+		l.Equal([]string{"init l s", "destroy l", "init l s"}, events)
+	}
+}
+
+func (l *LifecycleTestSuite) TestRestoreDestroyed() {
+	// -- Given
+	//
+	var events []string
+	inj := NewInjector()
+	inj.Add(NewKey("S"), "s")
+	inj.Add(NewKey("l"), newLifecycle(&lifecycle{Name: "l", Events: &events}))
+	_, _ = inj.Get(NewKey("l"))
+	snapshot := inj.Snapshot()
+	inj.Add(NewKey("l"), 1)
+
+	// -- When
+	//
+	inj.Restore(snapshot)
+
+	// -- Then
+	//
+	_, err := inj.Get(NewKey("l"))
+	if l.NoError(err) {
+		l.Equal([]string{"init l s", "destroy l", "init l s"}, events)
+	}
+}
+
+func (l *LifecycleTestSuite) TestPlainValueNotDestroyed() {
+	// -- Given
+	//
+	var events []string
+	inj := NewInjector()
+	inj.Add(NewKey("S"), "s")
+	inj.Add(NewKey("overwritten"), &lifecycle{Name: "overwritten", Events: &events})
+	inj.Add(NewKey("removed"), &lifecycle{Name: "removed", Events: &events})
+	inj.Add(NewKey("shutdown"), &lifecycle{Name: "shutdown", Events: &events})
+	for _, k := range []string{"overwritten", "removed", "shutdown"} {
+		_, err := inj.Get(NewKey(k))
+		l.Require().NoError(err)
+	}
+
+	// -- When
+	//
+	inj.Add(NewKey("overwritten"), 1)
+	removeErr := inj.Remove(NewKey("removed"))
+	shutdownErr := inj.Shutdown()
+
+	// -- Then
+	//
+	l.NoError(removeErr)
+	l.NoError(shutdownErr)
+	l.Equal([]string{"init overwritten s", "init removed s", "init shutdown s"}, events)
+}
+
+func (l *LifecycleTestSuite) TestRestorePlainValue() {
+	// -- Given
+	//
+	var events []string
+	original := &lifecycle{Name: "l", Events: &events}
+	inj := NewInjector()
+	inj.Add(NewKey("S"), "s")
+	inj.Add(NewKey("l"), original)
+	_, _ = inj.Get(NewKey("l"))
+	snapshot := inj.Snapshot()
+	inj.Add(NewKey("l"), &lifecycle{Name: "override", Events: &events})
+
+	// -- When
+	//
+	inj.Restore(snapshot)
+
+	// -- Then
+	//
+	actual, err := inj.Get(NewKey("l"))
+	if l.NoError(err) {
+		l.Same(original, actual)
+		l.Equal([]string{"init l s"}, events)
+	}
+}
+
+// newLifecycle returns a Factory that builds l so the Injector owns it.
+func newLifecycle(l *lifecycle) Factory {
+	return NewFactory[*lifecycle](func(_ Injector) (*lifecycle, error) {
+		return l, nil
+	})
+}
+
+type lifecycle struct {
+	S          string `inject:"S"`
+	Name       string
+	Events     *[]string
+	InitErr    error
+	DestroyErr error
+}
+
+func (l *lifecycle) Init() error {
+	*l.Events = append(*l.Events, "init "+l.Name+" "+l.S)
+	return l.InitErr
+}
+
+func (l *lifecycle) Destroy() error {
+	*l.Events = append(*l.Events, "destroy "+l.Name)
+	return l.DestroyErr
+}
+
+func TestLifecycleTestSuite(t *testing.T) {
+	suite.Run(t, new(LifecycleTestSuite))
+}
@@ -0,0 +1,95 @@
+package axon
+
+import (
+	"context"
+	"fmt"
+	"github.com/eddieowens/axon/opts"
+	"log/slog"
+)
+
+// RedactedValue replaces the value of any Key added via WithSecret within logs.
+const RedactedValue = "[REDACTED]"
+
+// WithLogger logs everything the Injector does to l. Adding Keys, constructing values, invalidating values, and
+// injecting structs are logged at slog.LevelDebug. Overwriting a Key is logged at slog.LevelInfo, failing to destroy a
+// value is logged at slog.LevelWarn, and failing to construct a value or inject a struct is logged at slog.LevelError.
+//
+//    inj := axon.NewInjector(axon.WithLogger(slog.Default()))
+func WithLogger(l *slog.Logger) opts.Opt[InjectorOpts] {
+	return WithObserver(NewLogObserver(l))
+}
+
+// NewLogObserver creates an Observer that logs every Event to l. See WithLogger for the levels each Event is logged at.
+func NewLogObserver(l *slog.Logger) Observer {
+	return &logObserver{Logger: l}
+}
+
+type logObserver struct {
+	Logger *slog.Logger
+}
+
+func (l *logObserver) OnEvent(e Event) {
+	level := slog.LevelDebug
+	msg := ""
+	attrs := make([]slog.Attr, 0, 6)
+	if !e.Key.IsEmpty() {
+		attrs = append(attrs, slog.String("key", e.Key.String()))
+	}
+
+	switch e.Type {
+	case EventKeyAdded, EventKeyOverwritten:
+		msg = "key added"
+		if e.Type == EventKeyOverwritten {
+			msg = "key overwritten"
+			level = slog.LevelInfo
+		}
+		attrs = append(attrs, typeAttr(e.Value), valueAttr(e))
+	case EventConstructStarted:
+		msg = "constructing value"
+	case EventConstructFinished:
+		msg = "constructed value"
+		if e.Err != nil {
+			msg = "failed to construct value"
+			level = slog.LevelError
+		} else {
+			attrs = append(attrs, typeAttr(e.Value), valueAttr(e))
+		}
+		attrs = append(attrs, slog.Duration("duration", e.Duration))
+	case EventInvalidated:
+		msg = "invalidated value"
+		if e.Err != nil {
+			msg = "failed to destroy value"
+			level = slog.LevelWarn
+		}
+		attrs = append(attrs, slog.String("reason", e.Reason.String()))
+		if !e.Cause.IsEmpty() {
+			attrs = append(attrs, slog.String("cause", e.Cause.String()))
+		}
+	case EventInjectCompleted:
+		msg = "injected value"
+		if e.Err != nil {
+			msg = "failed to inject value"
+			level = slog.LevelError
+		}
+		attrs = append(attrs, typeAttr(e.Value))
+	default:
+		return
+	}
+
+	if e.Err != nil {
+		attrs = append(attrs, slog.String("error", e.Err.Error()))
+	}
+
+	l.Logger.LogAttrs(context.Background(), level, msg, attrs...)
+}
+
+func typeAttr(val any) slog.Attr {
+	return slog.String("type", fmt.Sprintf("%T", val))
+}
+
+func valueAttr(e Event) slog.Attr {
+	if e.Secret {
+		return slog.String("value", RedactedValue)
+	}
+	return slog.Any("value", e.Value)
+}
@@ -0,0 +1,184 @@
+package axon
+
+import (
+	"bytes"
+	"errors"
+	"github.com/stretchr/testify/suite"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+type LoggerTestSuite struct {
+	suite.Suite
+	buf *bytes.Buffer
+	inj Injector
+}
+
+func (l *LoggerTestSuite) SetupTest() {
+	l.buf = new(bytes.Buffer)
+	logger := slog.New(slog.NewTextHandler(l.buf, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			switch a.Key {
+			case slog.TimeKey:
+				return slog.Attr{}
+			case "duration":
+				return slog.String("duration", "1ms")
+			}
+			return a
+		},
+	}))
+	l.inj = NewInjector(WithLogger(logger))
+}
+
+func (l *LoggerTestSuite) TestAdd() {
+	// -- When
+	//
+	l.inj.Add(NewKey("key"), 1)
+	l.inj.Add(NewKey("key"), 2)
+
+	// -- Then
+	//
+	l.Equal([]string{
+		`level=DEBUG msg="key added" key=key type=int value=1`,
+		`level=INFO msg="key overwritten" key=key type=int value=2`,
+	}, l.lines())
+}
+
+func (l *LoggerTestSuite) TestSecret() {
+	// -- Given
+	//
+	l.inj.Add(NewKey("password"), "hunter2", WithSecret())
+
+	// -- When
+	//
+	_, _ = l.inj.Get(NewKey("password"))
+
+	// -- Then
+	//
+	l.Equal([]string{
+		`level=DEBUG msg="key added" key=password type=string value=[REDACTED]`,
+		`level=DEBUG msg="constructing value" key=password`,
+		`level=DEBUG msg="constructed value" key=password type=string value=[REDACTED] duration=1ms`,
+	}, l.lines())
+	l.NotContains(l.buf.String(), "hunter2")
+}
+
+func (l *LoggerTestSuite) TestSecretPublicAdd() {
+	// -- Given
+	//
+	DefaultInjector = l.inj
+	defer func() {
+		DefaultInjector = NewInjector()
+	}()
+
+	// -- When
+	//
+	Add("password", "hunter2", WithSecret())
+
+	// -- Then
+	//
+	l.NotContains(l.buf.String(), "hunter2")
+}
+
+func (l *LoggerTestSuite) TestSecretConversionError() {
+	// -- Given
+	//
+	type server struct {
+		Port int `inject:"port"`
+	}
+
+	l.inj.Add(NewKey("port"), "hunter2", WithSecret())
+	l.inj.Add(NewKey("server"), new(server))
+
+	// -- When
+	//
+	_, getErr := l.inj.Get(NewKey("server"))
+	injectErr := l.inj.Inject(new(server))
+
+	// -- Then
+	//
+	l.ErrorIs(getErr, ErrInvalidType)
+	l.ErrorIs(injectErr, ErrInvalidType)
+	l.Contains(l.buf.String(), RedactedValue)
+	l.NotContains(l.buf.String(), "hunter2")
+	l.NotContains(getErr.Error(), "hunter2")
+}
+
+func (l *LoggerTestSuite) TestConstructFailed() {
+	// -- Given
+	//
+	l.inj.Add(NewKey("fact"), NewFactory[int](func(_ Injector) (int, error) {
+		return 0, errors.New("error")
+	}))
+	l.buf.Reset()
+
+	// -- When
+	//
+	_, _ = l.inj.Get(NewKey("fact"))
+
+	// -- Then
+	//
+	l.Equal([]string{
+		`level=DEBUG msg="constructing value" key=fact`,
+		`level=ERROR msg="failed to construct value" key=fact duration=1ms error=error`,
+	}, l.lines())
+}
+
+func (l *LoggerTestSuite) TestInvalidated() {
+	// -- Given
+	//
+	var events []string
+	l.inj.Add(NewKey("S"), "s")
+	l.inj.Add(NewKey("l"), newLifecycle(&lifecycle{Name: "l", Events: &events, DestroyErr: errors.New("destroy")}))
+	l.inj.Add(NewKey("i"), 1)
+	_, _ = l.inj.Get(NewKey("l"))
+	_, _ = l.inj.Get(NewKey("i"))
+	l.buf.Reset()
+
+	// -- When
+	//
+	_ = l.inj.Remove(NewKey("l"))
+	_ = l.inj.Remove(NewKey("S"))
+	_ = l.inj.Shutdown()
+
+	// -- Then
+	//
+	l.Equal([]string{
+		`level=WARN msg="failed to destroy value" key=l reason=Removed cause=l error=destroy`,
+		`level=DEBUG msg="invalidated value" key=S reason=Removed cause=S`,
+		`level=DEBUG msg="invalidated value" key=i reason=Shutdown`,
+	}, l.lines())
+}
+
+func (l *LoggerTestSuite) TestInject() {
+	// -- When
+	//
+	_ = l.inj.Inject(new(testDep))
+	_ = l.inj.Inject(1)
+
+	// -- Then
+	//
+	l.Equal([]string{
+		`level=ERROR msg="failed to inject value" type=*axon.testDep error="failed to inject S: not found"`,
+	}, l.lines())
+}
+
+func (l *LoggerTestSuite) TestUnknownEvent() {
+	// -- When
+	//
+	NewLogObserver(slog.New(slog.NewTextHandler(l.buf, nil))).OnEvent(Event{})
+
+	// -- Then
+	//
+	l.Empty(l.buf.String())
+}
+
+func (l *LoggerTestSuite) lines() []string {
+	return strings.Split(strings.TrimSpace(l.buf.String()), "\n")
+}
+
+func TestLoggerTestSuite(t *testing.T) {
+	suite.Run(t, new(LoggerTestSuite))
+}
@@ -0,0 +1,71 @@
+package axon
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// injectMethods calls the InjectMethod, if the struct has one, followed by each of the setters.
+func (i *injector) injectMethods(key Key, v reflect.Value, parent Span, setters []string) error {
+	if v.CanAddr() {
+		v = v.Addr()
+	}
+
+	if m := v.MethodByName(InjectMethod); m.IsValid() {
+		err := i.callMethod(key, m, InjectMethod, parent)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, name := range setters {
+		m := v.MethodByName(name)
+		if !m.IsValid() {
+			return fmt.Errorf("%w: type %s has no method %s", ErrInvalidMethod, v.Type().String(), name)
+		}
+
+		err := i.callMethod(key, m, name, parent)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// callMethod calls m with every parameter resolved by its type key.
+func (i *injector) callMethod(key Key, m reflect.Value, name string, parent Span) error {
+	typ := m.Type()
+	if typ.NumOut() > 1 || (typ.NumOut() == 1 && typ.Out(0) != errorType) {
+		return fmt.Errorf("%w: method %s must return nothing or an error", ErrInvalidMethod, name)
+	}
+
+	args := make([]reflect.Value, typ.NumIn())
+	for j := range args {
+		depKey := newKeyOfType(typ.In(j))
+		con, err := i.resolveValue(depKey, parent)
+		if err != nil {
+			return fmt.Errorf("failed to call method %s: %w", name, err)
+		}
+
+		args[j] = reflect.New(typ.In(j)).Elem()
+		err = i.setReflectVal(args[j], con, depKey)
+		if err != nil {
+			return fmt.Errorf("failed to call method %s: %w", name, err)
+		}
+
+		i.addDependencies(key, depKey)
+	}
+
+	var out []reflect.Value
+	if typ.IsVariadic() {
+		out = m.CallSlice(args)
+	} else {
+		out = m.Call(args)
+	}
+
+	if len(out) == 1 && !out[0].IsNil() {
+		return fmt.Errorf("failed to call method %s: %w", name, out[0].Interface().(error))
+	}
+	return nil
+}
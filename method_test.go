@@ -0,0 +1,196 @@
+package axon
+
+import (
+	"errors"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type MethodTestSuite struct {
+	suite.Suite
+}
+
+type methodDB struct {
+	Name string
+}
+
+type methodServer struct {
+	db      *methodDB
+	port    int
+	tags    []string
+	calls   int
+	initErr error
+}
+
+func (m *methodServer) InjectDependencies(db *methodDB, port int) error {
+	m.calls++
+	m.db = db
+	m.port = port
+	return m.initErr
+}
+
+func (m *methodServer) SetTags(tags ...string) {
+	m.tags = tags
+}
+
+func (m *methodServer) SetInvalid(_ *methodDB) (int, error) {
+	return 0, nil
+}
+
+func (m *MethodTestSuite) newInjector() Injector {
+	inj := NewInjector()
+	inj.Add(newTypeKey[*methodDB](), &methodDB{Name: "postgres"})
+	inj.Add(newTypeKey[int](), 8080)
+	inj.Add(newTypeKey[[]string](), []string{"a", "b"})
+	return inj
+}
+
+func (m *MethodTestSuite) TestInjectMethod() {
+	// -- Given
+	//
+	inj := m.newInjector()
+	inj.Add(NewKey("server"), new(methodServer), WithSetters("SetTags"))
+
+	// -- When
+	//
+	actual, err := inj.Get(NewKey("server"))
+
+	// -- Then
+	//
+	if m.NoError(err) {
+		srv := actual.(*methodServer)
+		m.Equal("postgres", srv.db.Name)
+		m.Equal(8080, srv.port)
+		m.Equal([]string{"a", "b"}, srv.tags)
+
+		info, _ := inj.Describe(NewKey("server"))
+		m.ElementsMatch([]Key{newTypeKey[*methodDB](), newTypeKey[int](), newTypeKey[[]string]()}, info.Dependencies)
+	}
+}
+
+func (m *MethodTestSuite) TestInjectMethodCascade() {
+	// -- Given
+	//
+	inj := m.newInjector()
+	inj.Add(NewKey("server"), new(methodServer))
+	_, err := inj.Get(NewKey("server"))
+	m.Require().NoError(err)
+
+	// -- When
+	//
+	inj.Add(newTypeKey[*methodDB](), &methodDB{Name: "mysql"})
+	actual, err := inj.Get(NewKey("server"))
+
+	// -- Then
+	//
+	if m.NoError(err) {
+		srv := actual.(*methodServer)
+		m.Equal("mysql", srv.db.Name)
+		m.Equal(2, srv.calls)
+	}
+}
+
+func (m *MethodTestSuite) TestInject() {
+	// -- Given
+	//
+	inj := m.newInjector()
+	given := new(methodServer)
+
+	// -- When
+	//
+	err := inj.Inject(given, WithInjectSetters("SetTags"))
+
+	// -- Then
+	//
+	if m.NoError(err) {
+		m.Equal("postgres", given.db.Name)
+		m.Equal([]string{"a", "b"}, given.tags)
+	}
+}
+
+func (m *MethodTestSuite) TestInjectMethodError() {
+	// -- Given
+	//
+	inj := m.newInjector()
+	given := &methodServer{initErr: errors.New("bad")}
+
+	// -- When
+	//
+	err := inj.Inject(given)
+
+	// -- Then
+	//
+	m.EqualError(err, "failed to call method InjectDependencies: bad")
+}
+
+func (m *MethodTestSuite) TestInjectMethodNotFound() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(newTypeKey[int](), 8080)
+
+	// -- When
+	//
+	err := inj.Inject(new(methodServer))
+
+	// -- Then
+	//
+	m.ErrorIs(err, ErrNotFound)
+}
+
+func (m *MethodTestSuite) TestInjectMethodInvalidParam() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(newTypeKey[*methodDB](), "postgres")
+	inj.Add(newTypeKey[int](), 8080)
+
+	// -- When
+	//
+	err := inj.Inject(new(methodServer))
+
+	// -- Then
+	//
+	m.ErrorIs(err, ErrInvalidType)
+}
+
+func (m *MethodTestSuite) TestSetterInvalid() {
+	// -- Given
+	//
+	inj := m.newInjector()
+
+	// -- When
+	//
+	missing := inj.Inject(new(methodServer), WithInjectSetters("SetMissing"))
+	invalid := inj.Inject(new(methodServer), WithInjectSetters("SetInvalid"))
+
+	// -- Then
+	//
+	m.ErrorIs(missing, ErrInvalidMethod)
+	m.ErrorIs(invalid, ErrInvalidMethod)
+}
+
+func (m *MethodTestSuite) TestSetterValueReceiver() {
+	// -- Given
+	//
+	inj := m.newInjector()
+	inj.Add(NewKey("server"), methodValue{}, WithSetters("Check"))
+
+	// -- When
+	//
+	_, err := inj.Get(NewKey("server"))
+
+	// -- Then
+	//
+	m.EqualError(err, "failed to call method Check: postgres")
+}
+
+type methodValue struct{}
+
+func (methodValue) Check(db *methodDB) error {
+	return errors.New(db.Name)
+}
+
+func TestMethodTestSuite(t *testing.T) {
+	suite.Run(t, new(MethodTestSuite))
+}
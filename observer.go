@@ -0,0 +1,147 @@
+package axon
+
+import (
+	"time"
+)
+
+// EventType the type of Event emitted by the Injector.
+type EventType int
+
+const (
+	// EventKeyAdded a Key that did not previously exist was added via Injector.Add.
+	EventKeyAdded EventType = iota + 1
+
+	// EventKeyOverwritten a Key that already existed was added again via Injector.Add.
+	EventKeyOverwritten
+
+	// EventConstructStarted the Injector started constructing the value for a Key.
+	EventConstructStarted
+
+	// EventConstructFinished the Injector finished constructing the value for a Key. Event.Duration holds how long the
+	// construction took and Event.Err holds the error if the construction failed.
+	EventConstructFinished
+
+	// EventInvalidated a constructed value was discarded by the Injector. Event.Reason holds why the value was discarded.
+	EventInvalidated
+
+	// EventInjectCompleted a call to Injector.Inject completed. Event.Value holds the value that was injected and
+	// Event.Err holds the error if the injection failed.
+	EventInjectCompleted
+)
+
+func (e EventType) String() string {
+	switch e {
+	case EventKeyAdded:
+		return "KeyAdded"
+	case EventKeyOverwritten:
+		return "KeyOverwritten"
+	case EventConstructStarted:
+		return "ConstructStarted"
+	case EventConstructFinished:
+		return "ConstructFinished"
+	case EventInvalidated:
+		return "Invalidated"
+	case EventInjectCompleted:
+		return "InjectCompleted"
+	}
+	return "Unknown"
+}
+
+// InvalidationReason why a value was invalidated by the Injector.
+type InvalidationReason int
+
+const (
+	// InvalidationOverwritten the Key for the value was overwritten via Injector.Add.
+	InvalidationOverwritten InvalidationReason = iota + 1
+
+	// InvalidationRemoved the Key for the value was removed via Injector.Remove.
+	InvalidationRemoved
+
+	// InvalidationShutdown the value was destroyed via Injector.Shutdown.
+	InvalidationShutdown
+
+	// InvalidationCascade a value the value depends on, either directly or transitively, was overwritten via
+	// Injector.Add. The Cause of the Event is the Key that was overwritten.
+	InvalidationCascade
+
+	// InvalidationRefreshed the value was refreshed via Injector.Refresh. The Cause of the Event is the Key passed to
+	// Injector.Refresh.
+	InvalidationRefreshed
+
+	// InvalidationRestored the value was constructed after the Snapshot passed to Injector.Restore was taken and was
+	// dropped along with its binding.
+	InvalidationRestored
+)
+
+func (r InvalidationReason) String() string {
+	switch r {
+	case InvalidationOverwritten:
+		return "Overwritten"
+	case InvalidationRemoved:
+		return "Removed"
+	case InvalidationShutdown:
+		return "Shutdown"
+	case InvalidationCascade:
+		return "Cascade"
+	case InvalidationRefreshed:
+		return "Refreshed"
+	case InvalidationRestored:
+		return "Restored"
+	}
+	return "Unknown"
+}
+
+// Event describes something the Injector did. Which fields are set depends on the Type of the Event.
+type Event struct {
+	Type EventType
+
+	// The Key the Event is about. Empty for EventInjectCompleted.
+	Key Key
+
+	// The value added for EventKeyAdded and EventKeyOverwritten, the constructed value for EventConstructFinished, and the
+	// value passed to Injector.Inject for EventInjectCompleted.
+	Value any
+
+	// How long the construction took for EventConstructFinished.
+	Duration time.Duration
+
+	// The error encountered, if any, for EventConstructFinished, EventInvalidated, and EventInjectCompleted.
+	Err error
+
+	// Why the value was invalidated for EventInvalidated.
+	Reason InvalidationReason
+
+	// The Key whose change caused the invalidation for EventInvalidated. Empty for InvalidationShutdown.
+	Cause Key
+
+	// True if the Key was added via WithSecret. If true, the Value should never be exposed.
+	Secret bool
+}
+
+// Observer receives Events from the Injector. OnEvent is called synchronously so implementations should return quickly.
+type Observer interface {
+	OnEvent(e Event)
+}
+
+// ObserverFunc allows a func to be used as an Observer.
+type ObserverFunc func(e Event)
+
+func (o ObserverFunc) OnEvent(e Event) {
+	o(e)
+}
+
+func (i *injector) AddObserver(o Observer) {
+	i.Lock.Lock()
+	defer i.Lock.Unlock()
+	i.Observers = append(i.Observers, o)
+}
+
+func (i *injector) emit(e Event) {
+	i.Lock.RLock()
+	observers := i.Observers
+	i.Lock.RUnlock()
+
+	for _, o := range observers {
+		o.OnEvent(e)
+	}
+}
@@ -0,0 +1,183 @@
+package axon
+
+import (
+	"errors"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type ObserverTestSuite struct {
+	suite.Suite
+}
+
+func (o *ObserverTestSuite) TestAdd() {
+	// -- Given
+	//
+	inj, events := o.newInjector()
+
+	// -- When
+	//
+	inj.Add(NewKey("key"), 1)
+	inj.Add(NewKey("key"), 2)
+
+	// -- Then
+	//
+	o.Equal([]Event{
+		{Type: EventKeyAdded, Key: NewKey("key"), Value: 1},
+		{Type: EventKeyOverwritten, Key: NewKey("key"), Value: 2},
+	}, *events)
+}
+
+func (o *ObserverTestSuite) TestConstruct() {
+	// -- Given
+	//
+	inj, events := o.newInjector()
+	inj.Add(NewKey("S"), "s")
+	inj.Add(NewKey("dep"), new(testDep))
+	*events = nil
+
+	// -- When
+	//
+	_, _ = inj.Get(NewKey("dep"))
+	_, _ = inj.Get(NewKey("dep"))
+
+	// -- Then
+	//
+	if o.Len(*events, 4) {
+		o.Equal(Event{Type: EventConstructStarted, Key: NewKey("dep")}, (*events)[0])
+		o.Equal(Event{Type: EventConstructStarted, Key: NewKey("S")}, (*events)[1])
+		o.Equal(EventConstructFinished, (*events)[2].Type)
+		o.Equal(NewKey("S"), (*events)[2].Key)
+		o.Equal("s", (*events)[2].Value)
+		o.Equal(EventConstructFinished, (*events)[3].Type)
+		o.Equal(NewKey("dep"), (*events)[3].Key)
+		o.Equal(&testDep{S: "s"}, (*events)[3].Value)
+		o.NoError((*events)[3].Err)
+		o.Positive((*events)[3].Duration)
+	}
+}
+
+func (o *ObserverTestSuite) TestConstructFailed() {
+	// -- Given
+	//
+	inj, events := o.newInjector()
+	inj.Add(NewKey("fact"), NewFactory[int](func(_ Injector) (int, error) {
+		return 0, errors.New("error")
+	}))
+	*events = nil
+
+	// -- When
+	//
+	_, _ = inj.Get(NewKey("fact"))
+
+	// -- Then
+	//
+	if o.Len(*events, 2) {
+		o.Equal(EventConstructFinished, (*events)[1].Type)
+		o.EqualError((*events)[1].Err, "error")
+		o.Nil((*events)[1].Value)
+	}
+}
+
+func (o *ObserverTestSuite) TestInvalidated() {
+	// -- Given
+	//
+	inj, events := o.newInjector()
+	inj.Add(NewKey("a"), 1)
+	inj.Add(NewKey("b"), 1)
+	inj.Add(NewKey("c"), 1)
+	inj.Add(NewKey("unbuilt"), 1)
+	_, _ = inj.Get(NewKey("a"))
+	_, _ = inj.Get(NewKey("b"))
+	_, _ = inj.Get(NewKey("c"))
+	*events = nil
+
+	// -- When
+	//
+	inj.Add(NewKey("a"), 2)
+	_ = inj.Remove(NewKey("b"))
+	_ = inj.Remove(NewKey("unbuilt"))
+	_ = inj.Shutdown()
+
+	// -- Then
+	//
+	o.Equal([]Event{
+		{Type: EventInvalidated, Key: NewKey("a"), Reason: InvalidationOverwritten, Cause: NewKey("a")},
+		{Type: EventKeyOverwritten, Key: NewKey("a"), Value: 2},
+		{Type: EventInvalidated, Key: NewKey("b"), Reason: InvalidationRemoved, Cause: NewKey("b")},
+		{Type: EventInvalidated, Key: NewKey("c"), Reason: InvalidationShutdown},
+	}, *events)
+}
+
+func (o *ObserverTestSuite) TestInjectCompleted() {
+	// -- Given
+	//
+	inj, events := o.newInjector()
+	given := new(testDep)
+
+	// -- When
+	//
+	err := inj.Inject(given)
+
+	// -- Then
+	//
+	o.Equal([]Event{{Type: EventInjectCompleted, Value: given, Err: err}}, *events)
+}
+
+func (o *ObserverTestSuite) TestStrings() {
+	o.Equal("KeyAdded", EventKeyAdded.String())
+	o.Equal("KeyOverwritten", EventKeyOverwritten.String())
+	o.Equal("ConstructStarted", EventConstructStarted.String())
+	o.Equal("ConstructFinished", EventConstructFinished.String())
+	o.Equal("Invalidated", EventInvalidated.String())
+	o.Equal("InjectCompleted", EventInjectCompleted.String())
+	o.Equal("Unknown", EventType(0).String())
+
+	o.Equal("Overwritten", InvalidationOverwritten.String())
+	o.Equal("Removed", InvalidationRemoved.String())
+	o.Equal("Shutdown", InvalidationShutdown.String())
+	o.Equal("Restored", InvalidationRestored.String())
+	o.Equal("Unknown", InvalidationReason(0).String())
+}
+
+func (o *ObserverTestSuite) TestAddObserverConcurrent() {
+	// -- Given
+	//
+	inj := NewInjector()
+	added := make(chan struct{})
+
+	// -- When
+	//
+	go func() {
+		for j := 0; j < 100; j++ {
+			inj.AddObserver(ObserverFunc(func(e Event) {}))
+		}
+		close(added)
+	}()
+	for j := 0; j < 100; j++ {
+		inj.Add(NewKey(j), j)
+	}
+	<-added
+
+	// -- Then
+	//
+	events := make([]Event, 0)
+	inj.AddObserver(ObserverFunc(func(e Event) {
+		events = append(events, e)
+	}))
+	inj.Add(NewKey("key"), 1)
+	o.Equal([]Event{{Type: EventKeyAdded, Key: NewKey("key"), Value: 1}}, events)
+}
+
+func (o *ObserverTestSuite) newInjector() (Injector, *[]Event) {
+	events := new([]Event)
+	inj := NewInjector()
+	inj.AddObserver(ObserverFunc(func(e Event) {
+		*events = append(*events, e)
+	}))
+	return inj, events
+}
+
+func TestObserverTestSuite(t *testing.T) {
+	suite.Run(t, new(ObserverTestSuite))
+}
@@ -0,0 +1,171 @@
+package axon
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// ParamFactoryFunc builds a T from the dependencies within the Injector along with a param supplied by the caller. Any
+// Injector.Get method calls are registered as dependencies of the Key the ParamFactory was added with.
+type ParamFactoryFunc[P, T any] func(inj Injector, param P) (T, error)
+
+// ParamFactory builds values which need a runtime param on top of their injected dependencies e.g. a UserSession for a
+// specific user ID. Add the ParamFactory like any other value and build values from it via InjectorGetWith.
+//
+//    inj.Add(axon.NewKey("session"), axon.NewParamFactory[string, *UserSession](newUserSession))
+//    session, err := axon.InjectorGetWith[*UserSession](inj, "session", userID)
+//
+// By default, the value built for each param is cached and returned for every subsequent call with an equal param.
+// Params that aren't comparable are never cached. Add the ParamFactory WithScope(ScopeTransient) to build a new value on
+// every call instead. The cache is cleared whenever the Key is invalidated e.g. one of its dependencies is overwritten.
+//
+// Each value is built the same way as a value added via a Factory e.g. fields tagged with the InjectTag are injected,
+// PostConstruct is called, the Decorators are applied, and a panic is returned as a ConstructionPanicError.
+type ParamFactory[P, T any] struct {
+	FactoryFunc ParamFactoryFunc[P, T]
+
+	lock  sync.Mutex
+	cache map[any]T
+}
+
+// paramFactory is implemented by every ParamFactory.
+type paramFactory interface {
+	isParamFactory()
+}
+
+func (p *ParamFactory[P, T]) isParamFactory() {}
+
+// NewParamFactory creates a ParamFactory.
+func NewParamFactory[P, T any](f ParamFactoryFunc[P, T]) *ParamFactory[P, T] {
+	return &ParamFactory[P, T]{FactoryFunc: f}
+}
+
+// Destroy clears the cache and calls PreDestroy.Destroy on every cached value that implements it. The first error
+// encountered is returned.
+func (p *ParamFactory[P, T]) Destroy() error {
+	p.lock.Lock()
+	cache := p.cache
+	p.cache = nil
+	p.lock.Unlock()
+
+	var firstErr error
+	for _, v := range cache {
+		if d, ok := any(v).(PreDestroy); ok {
+			err := d.Destroy()
+			if err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+// build gets the value for the param from the cache or constructs it. o are the opts the ParamFactory was added with.
+func (p *ParamFactory[P, T]) build(inj Injector, key Key, param P, o InjectorAddOpts) (out T, err error) {
+	cache := o.Scope != ScopeTransient && reflect.ValueOf(&param).Elem().Comparable()
+	if cache {
+		p.lock.Lock()
+		v, ok := p.cache[param]
+		p.lock.Unlock()
+		if ok {
+			return v, nil
+		}
+	}
+
+	out, err = p.construct(inj, key, param, o)
+	if err != nil {
+		return out, err
+	}
+
+	if cache {
+		p.lock.Lock()
+		defer p.lock.Unlock()
+		// another caller may have built the value for the same param in the meantime in which case this one is thrown
+		// away.
+		if v, ok := p.cache[param]; ok {
+			if d, ok := any(out).(PreDestroy); ok {
+				_ = d.Destroy()
+			}
+			return v, nil
+		}
+
+		if p.cache == nil {
+			p.cache = map[any]T{}
+		}
+		p.cache[param] = out
+	}
+
+	return out, nil
+}
+
+func (p *ParamFactory[P, T]) construct(inj Injector, key Key, param P, o InjectorAddOpts) (out T, err error) {
+	i := internalInjector(inj)
+	if i == nil {
+		return p.FactoryFunc(inj, param)
+	}
+
+	var parent Span
+	if kt, ok := inj.(*keyTracker); ok {
+		parent = kt.span
+	}
+
+	// the value is built the same way as any other e.g. events are emitted, panics are recovered, and the Decorators
+	// are applied.
+	provider := &containerProviderImpl[any]{
+		Key: key,
+		Factory: FactoryFunc[T](func(inj Injector) (T, error) {
+			return p.FactoryFunc(inj, param)
+		}),
+		Injector:    i,
+		OnConstruct: i.onConstruct(key, o),
+		AddOpts:     o,
+	}
+	con, err := provider.build(parent)
+	if err != nil {
+		return out, err
+	}
+	i.addDependencies(key, con.GetExternalDependencies()...)
+
+	if v := con.GetValue(); v != nil {
+		var ok bool
+		out, ok = v.(T)
+		if !ok {
+			return out, fmt.Errorf("%w: param factory for %s built type %T", ErrInvalidType, key.String(), v)
+		}
+	}
+	return out, nil
+}
+
+// GetWith same as InjectorGetWith but uses the DefaultInjector.
+func GetWith[T any, K InjectableKey, P any](key K, param P) (T, error) {
+	return InjectorGetWith[T](DefaultInjector, key, param)
+}
+
+// InjectorGetWith builds a T from the ParamFactory indexed by the key using the param. P must match the param type of
+// the ParamFactory exactly. See ParamFactory for caching.
+//
+//    inj.Add(axon.NewKey("session"), axon.NewParamFactory[string, *UserSession](newUserSession))
+//    session, err := axon.InjectorGetWith[*UserSession](inj, "session", "user-1")
+func InjectorGetWith[T any, K InjectableKey, P any](inj Injector, key K, param P) (out T, err error) {
+	k := injectableKeyToKey(key)
+	val, err := inj.Get(k)
+	if err != nil {
+		return out, err
+	}
+
+	pf, ok := val.(*ParamFactory[P, T])
+	if !ok {
+		return out, fmt.Errorf("%w: expected %s key to be type %T but got %T", ErrInvalidType, k.String(), pf, val)
+	}
+
+	var o InjectorAddOpts
+	if i := internalInjector(inj); i != nil {
+		// the Key was just found but may have been removed since.
+		if v := i.lookup(k); v != nil {
+			o = v.GetAddOpts()
+		}
+	}
+
+	return pf.build(inj, k, param, o)
+}
@@ -0,0 +1,431 @@
+package axon
+
+import (
+	"errors"
+	"github.com/stretchr/testify/suite"
+	"sync"
+	"testing"
+)
+
+type ParamFactoryTestSuite struct {
+	suite.Suite
+}
+
+type userSession struct {
+	UserID    string
+	DB        string `inject:"db"`
+	Inited    bool
+	Destroyed bool
+}
+
+func (u *userSession) Init() error {
+	u.Inited = true
+	return nil
+}
+
+func (u *userSession) Destroy() error {
+	u.Destroyed = true
+	return nil
+}
+
+func (p *ParamFactoryTestSuite) newInjector() (Injector, *int) {
+	builds := 0
+	inj := NewInjector()
+	inj.Add(NewKey("db"), "postgres")
+	inj.Add(NewKey("region"), "us")
+	inj.Add(NewKey("session"), NewParamFactory[string, *userSession](func(inj Injector, userID string) (*userSession, error) {
+		builds++
+		_, err := inj.Get(NewKey("region"))
+		return &userSession{UserID: userID}, err
+	}), WithDescription("sessions"))
+	return inj, &builds
+}
+
+func (p *ParamFactoryTestSuite) TestGetWith() {
+	// -- Given
+	//
+	inj, builds := p.newInjector()
+
+	// -- When
+	//
+	first, err := InjectorGetWith[*userSession](inj, "session", "user-1")
+	p.Require().NoError(err)
+	second, err := InjectorGetWith[*userSession](inj, "session", "user-1")
+	p.Require().NoError(err)
+	other, err := InjectorGetWith[*userSession](inj, NewKey("session"), "user-2")
+	p.Require().NoError(err)
+
+	// -- Then
+	//
+	p.Same(first, second)
+	p.NotSame(first, other)
+	p.Equal(2, *builds)
+	p.Equal("user-1", first.UserID)
+	p.Equal("user-2", other.UserID)
+	p.Equal("postgres", first.DB)
+	p.True(first.Inited)
+
+	info, _ := inj.Describe(NewKey("session"))
+	p.Equal(ScopeSingleton, info.Scope)
+	p.ElementsMatch([]Key{NewKey("db"), NewKey("region")}, info.Dependencies)
+}
+
+func (p *ParamFactoryTestSuite) TestGetWithTransient() {
+	// -- Given
+	//
+	builds := 0
+	inj := NewInjector()
+	inj.Add(NewKey("db"), "postgres")
+	inj.Add(NewKey("session"), NewParamFactory[string, *userSession](func(_ Injector, userID string) (*userSession, error) {
+		builds++
+		return &userSession{UserID: userID}, nil
+	}), WithScope(ScopeTransient))
+
+	// -- When
+	//
+	first, err := InjectorGetWith[*userSession](inj, "session", "user-1")
+	p.Require().NoError(err)
+	second, err := InjectorGetWith[*userSession](inj, "session", "user-1")
+	p.Require().NoError(err)
+
+	// -- Then
+	//
+	p.NotSame(first, second)
+	p.Equal(2, builds)
+	info, _ := inj.Describe(NewKey("session"))
+	p.Equal(ScopeTransient, info.Scope)
+}
+
+func (p *ParamFactoryTestSuite) TestGetWithNotComparable() {
+	// -- Given
+	//
+	builds := 0
+	inj := NewInjector()
+	inj.Add(NewKey("sum"), NewParamFactory[any, int](func(_ Injector, param any) (int, error) {
+		builds++
+		out := 0
+		for _, v := range param.([]int) {
+			out += v
+		}
+		return out, nil
+	}))
+
+	// -- When
+	//
+	first, err := InjectorGetWith[int](inj, "sum", any([]int{1, 2}))
+	p.Require().NoError(err)
+	second, err := InjectorGetWith[int](inj, "sum", any([]int{1, 2}))
+	p.Require().NoError(err)
+
+	// -- Then
+	//
+	p.Equal(3, first)
+	p.Equal(3, second)
+	p.Equal(2, builds)
+}
+
+func (p *ParamFactoryTestSuite) TestGetWithInvalidated() {
+	// -- Given
+	//
+	inj, builds := p.newInjector()
+	first, err := InjectorGetWith[*userSession](inj, "session", "user-1")
+	p.Require().NoError(err)
+
+	// -- When
+	//
+	inj.Add(NewKey("region"), "eu")
+	second, err := InjectorGetWith[*userSession](inj, "session", "user-1")
+
+	// -- Then
+	//
+	p.Require().NoError(err)
+	p.NotSame(first, second)
+	p.True(first.Destroyed)
+	p.False(second.Destroyed)
+	p.Equal(2, *builds)
+}
+
+func (p *ParamFactoryTestSuite) TestGetWithDependency() {
+	// -- Given
+	//
+	inj, _ := p.newInjector()
+	inj.Add(NewKey("handler"), NewFactory[string](func(inj Injector) (string, error) {
+		s, err := InjectorGetWith[*userSession](inj, "session", "user-1")
+		if err != nil {
+			return "", err
+		}
+		return s.UserID, nil
+	}))
+
+	// -- When
+	//
+	actual, err := inj.Get(NewKey("handler"))
+
+	// -- Then
+	//
+	if p.NoError(err) {
+		p.Equal("user-1", actual)
+		info, _ := inj.Describe(NewKey("handler"))
+		p.Equal([]Key{NewKey("session")}, info.Dependencies)
+	}
+}
+
+func (p *ParamFactoryTestSuite) TestGetWithError() {
+	// -- Given
+	//
+	fail := true
+	inj := NewInjector()
+	inj.Add(NewKey("db"), "postgres")
+	inj.Add(NewKey("session"), NewParamFactory[string, *userSession](func(_ Injector, userID string) (*userSession, error) {
+		if fail {
+			return nil, errors.New("unavailable")
+		}
+		return &userSession{UserID: userID}, nil
+	}))
+
+	// -- When
+	//
+	_, err := InjectorGetWith[*userSession](inj, "session", "user-1")
+	fail = false
+	actual, retryErr := InjectorGetWith[*userSession](inj, "session", "user-1")
+
+	// -- Then
+	//
+	p.EqualError(err, "unavailable")
+	if p.NoError(retryErr) {
+		p.Equal("user-1", actual.UserID)
+	}
+}
+
+func (p *ParamFactoryTestSuite) TestGetWithPanic() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("session"), NewParamFactory[string, *userSession](func(_ Injector, userID string) (*userSession, error) {
+		panic("no session for " + userID)
+	}))
+
+	// -- When
+	//
+	actual, err := InjectorGetWith[*userSession](inj, "session", "user-1")
+
+	// -- Then
+	//
+	var panicErr *ConstructionPanicError
+	if p.ErrorAs(err, &panicErr) {
+		p.Equal(NewKey("session"), panicErr.Key)
+		p.Equal("no session for user-1", panicErr.Value)
+	}
+	p.Nil(actual)
+}
+
+func (p *ParamFactoryTestSuite) TestGetWithObserved() {
+	// -- Given
+	//
+	var events []Event
+	inj := NewInjector(WithObserver(ObserverFunc(func(e Event) {
+		if e.Type == EventConstructStarted || e.Type == EventConstructFinished {
+			events = append(events, e)
+		}
+	})))
+	inj.Add(NewKey("greeting"), NewParamFactory[string, string](func(_ Injector, name string) (string, error) {
+		return "hello " + name, nil
+	}))
+	Decorate(inj, func(val string, _ Injector) (string, error) {
+		return val + "!", nil
+	})
+
+	// -- When
+	//
+	actual, err := InjectorGetWith[string](inj, "greeting", "axon")
+
+	// -- Then
+	//
+	if p.NoError(err) {
+		p.Equal("hello axon!", actual)
+	}
+	if p.Len(events, 4) {
+		p.Equal(Event{Type: EventConstructStarted, Key: NewKey("greeting")}, events[2])
+		p.Equal(NewKey("greeting"), events[3].Key)
+		p.Equal("hello axon!", events[3].Value)
+	}
+}
+
+func (p *ParamFactoryTestSuite) TestGetWithDecoratedType() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("greeting"), NewParamFactory[string, string](func(_ Injector, name string) (string, error) {
+		return "hello " + name, nil
+	}))
+	Decorate(inj, func(val any, _ Injector) (any, error) {
+		if s, ok := val.(string); ok {
+			return len(s), nil
+		}
+		return val, nil
+	})
+
+	// -- When
+	//
+	_, err := InjectorGetWith[string](inj, "greeting", "axon")
+
+	// -- Then
+	//
+	p.EqualError(err, "invalid type: param factory for greeting built type int")
+}
+
+func (p *ParamFactoryTestSuite) TestGetWithOtherInjector() {
+	// -- Given
+	//
+	type otherInjector struct {
+		Injector
+	}
+
+	inj, builds := p.newInjector()
+
+	// -- When
+	//
+	actual, err := InjectorGetWith[*userSession](otherInjector{Injector: inj}, "session", "user-1")
+
+	// -- Then
+	//
+	if p.NoError(err) {
+		p.Equal("user-1", actual.UserID)
+		p.Equal(1, *builds)
+	}
+}
+
+func (p *ParamFactoryTestSuite) TestGetWithInjectError() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("session"), NewParamFactory[string, *userSession](func(_ Injector, userID string) (*userSession, error) {
+		return &userSession{UserID: userID}, nil
+	}))
+
+	// -- When
+	//
+	_, err := InjectorGetWith[*userSession](inj, "session", "user-1")
+
+	// -- Then
+	//
+	p.ErrorIs(err, ErrNotFound)
+}
+
+func (p *ParamFactoryTestSuite) TestGetWithInvalidType() {
+	// -- Given
+	//
+	inj, _ := p.newInjector()
+
+	// -- When
+	//
+	_, wrongParam := InjectorGetWith[*userSession](inj, "session", 1)
+	_, notFactory := InjectorGetWith[string](inj, "db", "user-1")
+	_, notFound := InjectorGetWith[string](inj, "missing", "user-1")
+
+	// -- Then
+	//
+	p.ErrorIs(wrongParam, ErrInvalidType)
+	p.ErrorIs(notFactory, ErrInvalidType)
+	p.ErrorIs(notFound, ErrNotFound)
+}
+
+func (p *ParamFactoryTestSuite) TestGetWithDefaultInjector() {
+	// -- Given
+	//
+	DefaultInjector = NewInjector()
+	Add("double", NewParamFactory[int, int](func(_ Injector, param int) (int, error) {
+		return param * 2, nil
+	}))
+
+	// -- When
+	//
+	actual, err := GetWith[int]("double", 21)
+
+	// -- Then
+	//
+	if p.NoError(err) {
+		p.Equal(42, actual)
+	}
+}
+
+func (p *ParamFactoryTestSuite) TestDestroy() {
+	// -- Given
+	//
+	destroyErr := errors.New("destroy")
+	given := NewParamFactory[int, *destroyable](func(_ Injector, param int) (*destroyable, error) {
+		return &destroyable{Err: destroyErr}, nil
+	})
+	first, _ := given.build(NewInjector(), NewKey("d"), 1, InjectorAddOpts{})
+	_, _ = given.build(NewInjector(), NewKey("d"), 2, InjectorAddOpts{})
+
+	// -- When
+	//
+	err := given.Destroy()
+
+	// -- Then
+	//
+	p.ErrorIs(err, destroyErr)
+	p.True(first.Destroyed)
+	second, _ := given.build(NewInjector(), NewKey("d"), 1, InjectorAddOpts{})
+	p.NotSame(first, second)
+}
+
+func (p *ParamFactoryTestSuite) TestBuildConcurrentDuplicate() {
+	// -- Given
+	//
+	lock := sync.Mutex{}
+	var built []*destroyable
+	started := sync.WaitGroup{}
+	started.Add(2)
+	given := NewParamFactory[int, *destroyable](func(_ Injector, param int) (*destroyable, error) {
+		// both callers miss the cache before either stores its value.
+		started.Done()
+		started.Wait()
+
+		lock.Lock()
+		defer lock.Unlock()
+		built = append(built, &destroyable{})
+		return built[len(built)-1], nil
+	})
+	inj := NewInjector()
+
+	// -- When
+	//
+	wg := sync.WaitGroup{}
+	actual := make([]*destroyable, 2)
+	for j := range actual {
+		wg.Add(1)
+		go func(j int) {
+			defer wg.Done()
+			actual[j], _ = given.build(inj, NewKey("d"), 1, InjectorAddOpts{})
+		}(j)
+	}
+	wg.Wait()
+
+	// -- Then
+	//
+	p.Same(actual[0], actual[1])
+	p.False(actual[0].Destroyed)
+	if p.Len(built, 2) {
+		discarded := built[0]
+		if discarded == actual[0] {
+			discarded = built[1]
+		}
+		p.True(discarded.Destroyed)
+	}
+}
+
+type destroyable struct {
+	Err       error
+	Destroyed bool
+}
+
+func (d *destroyable) Destroy() error {
+	d.Destroyed = true
+	return d.Err
+}
+
+func TestParamFactoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ParamFactoryTestSuite))
+}
@@ -1,8 +1,11 @@
 package axon
 
 import (
+	"fmt"
 	"github.com/eddieowens/axon/opts"
+	"reflect"
 	"sync"
+	"time"
 )
 
 // Provider allows values to be mutated in real-time in a thread-safe manner. Providers should be used when you have a
@@ -57,6 +60,11 @@ func (p *Provider[T]) SetValue(val any) error {
 	return nil
 }
 
+// snapshotValue returns the value currently held by the Provider so that it can be handed back to SetValue later on.
+func (p *Provider[T]) snapshotValue() any {
+	return p.Get()
+}
+
 func (p *Provider[T]) Get() T {
 	p.lock.RLock()
 	defer p.lock.RUnlock()
@@ -64,7 +72,13 @@ func (p *Provider[T]) Get() T {
 }
 
 type containerProvider[T any] interface {
-	ProvideContainer() (container[T], error)
+	// ProvideContainer returns the container for the value, constructing the value if needed. parent is the Span of the
+	// value that depends on this one, if any.
+	ProvideContainer(parent Span) (container[T], error)
+
+	// GetContainer returns the container for the value or nil if the value hasn't been constructed. Unlike
+	// ProvideContainer, the value is never constructed.
+	GetContainer() container[T]
 	Invalidate()
 	SetConstructor(constructor OnConstructFunc[T])
 
@@ -74,12 +88,52 @@ type containerProvider[T any] interface {
 
 	// IsInstantiated returns true if ProvideContainer has ever been called, false otherwise.
 	IsInstantiated() bool
+
+	// GetType returns the type of the value. If the type can't be known until the value is constructed and the value
+	// has not been constructed, nil is returned.
+	GetType() reflect.Type
+
+	// IsFactory returns true if the value is constructed via a Factory, false otherwise.
+	IsFactory() bool
+
+	// GetState returns the results of IsInstantiated and GetType along with how long the last successful construction
+	// of the value took. They're read at the same time so they agree with one another even while the value is being
+	// constructed.
+	GetState() providerState
+
+	// GetAddOpts returns the opts the containerProvider was added to the Injector with.
+	GetAddOpts() InjectorAddOpts
+
+	SetAddOpts(o InjectorAddOpts)
+
+	// Destroy destroys the constructed value, if any, and resets the containerProvider so that the next call to
+	// ProvideContainer constructs the value again. If the value is being constructed, Destroy waits for it to finish
+	// and destroys the result. destroyed is true if there was a constructed value.
+	Destroy() (destroyed bool, err error)
+
+	// Refresh same as Destroy except if the constructed value is a MutableValue, the next constructed value is pushed into
+	// it via MutableValue.SetValue and the MutableValue is kept as the value.
+	Refresh() (refreshed bool, err error)
+
+	// Clone returns a copy of the containerProvider that is unaffected by future changes to the original e.g. calls to
+	// Invalidate or SetConstructor.
+	Clone() containerProvider[T]
 }
 
-type OnConstructFunc[T any] func(constructed container[T]) error
+// providerState a consistent view of a containerProvider. See containerProvider.GetState.
+type providerState struct {
+	Instantiated bool
+	Type         reflect.Type
+	Duration     time.Duration
+}
 
-func newContainerProvider(inj Injector, val any) containerProvider[any] {
+// OnConstructFunc is called whenever a value is constructed by a containerProvider. The returned value replaces the
+// constructed value. Any calls to kt.Get are registered as dependencies of the constructed value.
+type OnConstructFunc[T any] func(constructed T, kt *keyTracker) (T, error)
+
+func newContainerProvider(inj *injector, key Key, val any) containerProvider[any] {
 	p := &containerProviderImpl[any]{
+		Key:      key,
 		Value:    val,
 		Injector: inj,
 	}
@@ -92,19 +146,44 @@ func newContainerProvider(inj Injector, val any) containerProvider[any] {
 	internal, ok := val.(internalFactory)
 	if ok {
 		p.Value = internal.GetZeroValue()
+		p.Type = internal.GetType()
+	} else if !p.IsFactory() && val != nil {
+		p.Type = reflect.TypeOf(val)
 	}
 
 	return p
 }
 
 type containerProviderImpl[T any] struct {
-	Value        T
+	Key     Key
+	Value   T
+	Factory Factory
+
+	// Guards construction.
+	Lock sync.Mutex
+
+	// Guards Container, Instantiated, Err, Duration, and Holder so they can be read while the value is being
+	// constructed. They're only written while both Lock and State are held so holding either is enough to read them.
+	// Unlike Lock, State is never held during construction.
+	State        sync.RWMutex
 	Container    container[T]
-	Factory      Factory
-	Once         sync.Once
 	Instantiated bool
-	Injector     Injector
-	OnConstruct  OnConstructFunc[T]
+
+	// Set once the construction error was cached via RetryPolicy.CacheError.
+	Err error
+
+	Injector    *injector
+	OnConstruct OnConstructFunc[T]
+	AddOpts     InjectorAddOpts
+
+	// The type of the value if it's known before construction.
+	Type reflect.Type
+
+	// How long the last successful construction took.
+	Duration time.Duration
+
+	// The MutableValue the next constructed value is pushed into. See Refresh.
+	Holder MutableValue
 }
 
 func (p *containerProviderImpl[T]) GetValue() T {
@@ -112,59 +191,281 @@ func (p *containerProviderImpl[T]) GetValue() T {
 }
 
 func (p *containerProviderImpl[T]) IsInstantiated() bool {
+	p.State.RLock()
+	defer p.State.RUnlock()
 	return p.Instantiated
 }
 
+func (p *containerProviderImpl[T]) GetContainer() container[T] {
+	p.State.RLock()
+	defer p.State.RUnlock()
+	return p.Container
+}
+
+func (p *containerProviderImpl[T]) GetType() reflect.Type {
+	p.State.RLock()
+	defer p.State.RUnlock()
+	return p.getType()
+}
+
+// getType same as GetType but State must be held.
+func (p *containerProviderImpl[T]) getType() reflect.Type {
+	if p.Type != nil || p.Container == nil {
+		return p.Type
+	}
+	return reflect.TypeOf(p.Container.GetValue())
+}
+
+func (p *containerProviderImpl[T]) IsFactory() bool {
+	return p.Factory != nil
+}
+
+func (p *containerProviderImpl[T]) GetState() providerState {
+	p.State.RLock()
+	defer p.State.RUnlock()
+	return providerState{
+		Instantiated: p.Instantiated,
+		Type:         p.getType(),
+		Duration:     p.Duration,
+	}
+}
+
+func (p *containerProviderImpl[T]) GetAddOpts() InjectorAddOpts {
+	return p.AddOpts
+}
+
+func (p *containerProviderImpl[T]) SetAddOpts(o InjectorAddOpts) {
+	p.AddOpts = o
+}
+
 func (p *containerProviderImpl[T]) SetConstructor(constructor OnConstructFunc[T]) {
 	p.OnConstruct = constructor
 }
 
-func (p *containerProviderImpl[T]) ProvideContainer() (container[T], error) {
-	var err error
-	p.Once.Do(func() {
-		val := p.Value
-		if p.Container == nil {
-			kt := newKeyTracker(p.Injector)
-			if p.Factory != nil {
-				var v any
-				v, err = p.Factory.Build(kt)
-				if err != nil {
-					return
-				}
-				val = v.(T)
-			}
-			p.Container = newContainer(val, kt.keysGotten...)
+func (p *containerProviderImpl[T]) ProvideContainer(parent Span) (container[T], error) {
+	p.Lock.Lock()
+	defer p.Lock.Unlock()
+
+	if p.Err != nil {
+		return nil, p.Err
+	}
+
+	// transient values are never cached so every call constructs a new one.
+	if p.AddOpts.Scope == ScopeTransient && p.IsFactory() {
+		return p.build(parent)
+	}
 
-			if p.OnConstruct != nil {
-				err = p.OnConstruct(p.Container)
+	if p.Container == nil || p.Container.IsDestroyed() {
+		con, err := p.build(parent)
+		if err != nil {
+			if p.AddOpts.RetryPolicy.CacheError {
+				p.State.Lock()
+				p.Err = err
+				p.State.Unlock()
 			}
+			return nil, err
 		}
-	})
-	if err != nil {
-		return nil, err
+
+		p.State.Lock()
+		p.Container = con
+		p.Instantiated = true
+		p.State.Unlock()
 	}
 
-	p.Instantiated = true
 	return p.Container, nil
 }
 
+// build constructs the value, retrying according to the RetryPolicy.
+func (p *containerProviderImpl[T]) build(parent Span) (con container[T], err error) {
+	policy := p.AddOpts.RetryPolicy
+	for attempt := 1; ; attempt++ {
+		con, err = p.attempt(parent)
+		if err == nil || attempt >= policy.MaxAttempts {
+			return con, err
+		}
+
+		if policy.Backoff != nil {
+			sleep(policy.Backoff(attempt))
+		}
+	}
+}
+
+// attempt makes a single attempt at constructing the value.
+func (p *containerProviderImpl[T]) attempt(parent Span) (container[T], error) {
+	p.Injector.emit(Event{Type: EventConstructStarted, Key: p.Key, Secret: p.AddOpts.Secret})
+	span := p.Injector.startSpan(parent, p.Key)
+	start := time.Now()
+
+	var constructed any
+	con, err := p.construct(span)
+	span.End(err)
+	dur := time.Since(start)
+	if err == nil {
+		p.State.Lock()
+		p.Duration = dur
+		p.State.Unlock()
+		constructed = con.GetValue()
+	}
+
+	p.Injector.emit(Event{
+		Type:     EventConstructFinished,
+		Key:      p.Key,
+		Value:    constructed,
+		Duration: dur,
+		Err:      err,
+		Secret:   p.AddOpts.Secret,
+	})
+
+	return con, err
+}
+
+func (p *containerProviderImpl[T]) construct(span Span) (con container[T], err error) {
+	defer recoverPanic(p.Key, &err)
+
+	val := p.Value
+	kt := newKeyTracker(p.Injector, p.Key, span)
+	if p.Factory != nil {
+		v, err := p.Factory.Build(kt)
+		if err != nil {
+			return nil, err
+		}
+
+		var zero T
+		val = zero
+		if v != nil {
+			var ok bool
+			val, ok = v.(T)
+			if !ok {
+				return nil, fmt.Errorf("%w: factory for %s built type %T", ErrInvalidType, p.Key.String(), v)
+			}
+		}
+	}
+
+	if p.OnConstruct != nil {
+		var err error
+		val, err = p.OnConstruct(val, kt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if p.Holder != nil {
+		if !isSame(p.Holder, val) {
+			err := p.Holder.SetValue(val)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		val = any(p.Holder).(T)
+		p.State.Lock()
+		p.Holder = nil
+		p.State.Unlock()
+	}
+
+	return newContainer(val, kt.keysGotten...), nil
+}
+
+func (p *containerProviderImpl[T]) Clone() containerProvider[T] {
+	p.State.RLock()
+	defer p.State.RUnlock()
+	return &containerProviderImpl[T]{
+		Key:          p.Key,
+		Value:        p.Value,
+		Container:    p.Container,
+		Factory:      p.Factory,
+		Instantiated: p.Instantiated,
+		Injector:     p.Injector,
+		OnConstruct:  p.OnConstruct,
+		AddOpts:      p.AddOpts,
+		Type:         p.Type,
+		Duration:     p.Duration,
+		Holder:       p.Holder,
+		Err:          p.Err,
+	}
+}
+
+func (p *containerProviderImpl[T]) Destroy() (bool, error) {
+	p.Lock.Lock()
+	defer p.Lock.Unlock()
+	return p.destroy()
+}
+
+func (p *containerProviderImpl[T]) destroy() (bool, error) {
+	p.State.Lock()
+	defer p.State.Unlock()
+
+	p.Err = nil
+	if p.Container == nil {
+		return false, nil
+	}
+
+	var err error
+	if p.ownsValue() {
+		err = p.Container.Destroy()
+	}
+	p.Container = nil
+	p.Instantiated = false
+	return true, err
+}
+
+// ownsValue returns true if the value was built by the Injector and should be destroyed along with the container.
+// Values added as is belong to the caller. A ParamFactory is owned as the values it caches were built by the Injector.
+func (p *containerProviderImpl[T]) ownsValue() bool {
+	if p.IsFactory() {
+		return true
+	}
+	_, ok := any(p.Container.GetValue()).(paramFactory)
+	return ok
+}
+
+func (p *containerProviderImpl[T]) Refresh() (bool, error) {
+	p.Lock.Lock()
+	defer p.Lock.Unlock()
+
+	var holder MutableValue
+	if p.Container != nil {
+		holder, _ = any(p.Container.GetValue()).(MutableValue)
+	}
+
+	refreshed, err := p.destroy()
+	p.State.Lock()
+	p.Holder = holder
+	p.State.Unlock()
+	return refreshed, err
+}
+
 func (p *containerProviderImpl[T]) Invalidate() {
-	p.Once = sync.Once{}
+	p.Lock.Lock()
+	defer p.Lock.Unlock()
+	p.State.Lock()
+	defer p.State.Unlock()
+	p.Err = nil
 }
 
-func newKeyTracker(i Injector) *keyTracker {
+func newKeyTracker(i *injector, key Key, span Span) *keyTracker {
 	return &keyTracker{
 		Injector:   i,
+		injector:   i,
+		key:        key,
+		span:       span,
 		keysGotten: make([]Key, 0),
 	}
 }
 
 type keyTracker struct {
 	Injector
+	injector *injector
+
+	// The Key of the value being constructed.
+	key Key
+
+	// The Span of the value being constructed. All values gotten through the keyTracker are children of this Span.
+	span       Span
 	keysGotten []Key
 }
 
-func (t *keyTracker) Get(k Key, _ ...opts.Opt[InjectorGetOpts]) (any, error) {
+func (t *keyTracker) Get(k Key, ops ...opts.Opt[InjectorGetOpts]) (any, error) {
 	t.keysGotten = append(t.keysGotten, k)
-	return t.Injector.Get(k)
+	return t.injector.getWithOpts(k, t.span, opts.ApplyOpts(&InjectorGetOpts{}, ops...))
 }
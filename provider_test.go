@@ -1,6 +1,8 @@
 package axon
 
 import (
+	"errors"
+	"github.com/eddieowens/axon/internal/depgraph"
 	"github.com/stretchr/testify/suite"
 	"testing"
 )
@@ -37,6 +39,129 @@ func (p *ProviderTestSuite) TestSetValueWrongType() {
 	p.NoError(err)
 }
 
+func (p *ProviderTestSuite) TestFactoryPanic() {
+	// -- Given
+	//
+	panics := true
+	inj := NewInjector()
+	inj.Add(NewKey("db"), NewFactory[string](func(_ Injector) (string, error) {
+		if panics {
+			panic("boom")
+		}
+		return "db", nil
+	}))
+
+	// -- When
+	//
+	_, err := inj.Get(NewKey("db"))
+	panics = false
+	actual, retryErr := inj.Get(NewKey("db"))
+
+	// -- Then
+	//
+	var panicErr *ConstructionPanicError
+	if p.ErrorAs(err, &panicErr) {
+		p.EqualError(err, "panic while constructing db: boom")
+		p.Equal(NewKey("db"), panicErr.Key)
+		p.Equal("boom", panicErr.Value)
+		p.Contains(string(panicErr.Stack), "TestFactoryPanic")
+		p.Nil(panicErr.Unwrap())
+	}
+
+	if p.NoError(retryErr) {
+		p.Equal("db", actual)
+	}
+}
+
+func (p *ProviderTestSuite) TestDependencyPanic() {
+	// -- Given
+	//
+	type server struct {
+		DB string `inject:"db"`
+	}
+
+	given := errors.New("boom")
+	inj := NewInjector()
+	inj.Add(NewKey("db"), NewFactory[string](func(_ Injector) (string, error) {
+		panic(given)
+	}))
+	inj.Add(NewKey("server"), new(server))
+
+	// -- When
+	//
+	_, err := inj.Get(NewKey("server"))
+
+	// -- Then
+	//
+	var panicErr *ConstructionPanicError
+	if p.ErrorAs(err, &panicErr) {
+		p.Equal(NewKey("db"), panicErr.Key)
+	}
+	p.ErrorIs(err, given)
+	info, _ := inj.Describe(NewKey("server"))
+	p.False(info.Instantiated)
+}
+
+func (p *ProviderTestSuite) TestPostConstructPanic() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("init"), new(panickingInit))
+
+	// -- When
+	//
+	_, err := inj.Get(NewKey("init"))
+
+	// -- Then
+	//
+	p.EqualError(err, "panic while constructing init: init")
+}
+
+func (p *ProviderTestSuite) TestFactoryNil() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("err"), NewFactory[error](func(_ Injector) (error, error) {
+		return nil, nil
+	}))
+
+	// -- When
+	//
+	actual, err := inj.Get(NewKey("err"))
+
+	// -- Then
+	//
+	p.NoError(err)
+	p.Nil(actual)
+}
+
+func (p *ProviderTestSuite) TestFactoryWrongType() {
+	// -- Given
+	//
+	given := &containerProviderImpl[string]{
+		Key: NewKey("str"),
+		Factory: FactoryFunc[int](func(_ Injector) (int, error) {
+			return 1, nil
+		}),
+		Injector: &injector{DepGraph: depgraph.NewDoubleMap[containerProvider[any]]()},
+	}
+
+	// -- When
+	//
+	_, err := given.ProvideContainer(nil)
+
+	// -- Then
+	//
+	p.ErrorIs(err, ErrInvalidType)
+	p.EqualError(err, "invalid type: factory for str built type int")
+}
+
+type panickingInit struct{}
+
+func (p *panickingInit) Init() error {
+	panic("init")
+}
+
 func TestProviderTestSuite(t *testing.T) {
 	suite.Run(t, new(ProviderTestSuite))
 }
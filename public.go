@@ -4,14 +4,16 @@ package axon
 import (
 	"fmt"
 	"github.com/eddieowens/axon/opts"
+	"reflect"
 )
 
 // DefaultInjector acts as a global-level Injector for all operations. If you want to create your own Injector, use NewInjector.
 var DefaultInjector = NewInjector()
 
-// InjectableKey is a type constraint for the supported keys within the Injector.
+// InjectableKey is a type constraint for the supported keys within the Injector. A Key or a TypedKey is used as is, an
+// empty string is the type key of the value (see NewTypeKey), and any other value is converted via NewKey.
 type InjectableKey interface {
-	Key | string
+	comparable
 }
 
 // Inject injects all fields in val marked with the InjectTag using the DefaultInjector. If any errors are encountered
@@ -33,6 +35,20 @@ func WithKey[V InjectableKey](k V) opts.Opt[GetOpts] {
 type GetOpts struct {
 	// A specific Key to get from the Injector.
 	Key Key
+
+	// The opts passed to Injector.Get. See WithGetOpts.
+	InjectorGetOpts
+}
+
+// WithGetOpts passes opts for the Injector.Get method to the Get funcs e.g.
+//
+//    port := axon.MustGet[int](axon.WithKey("port"), axon.WithGetOpts(axon.WithDefault(8080)))
+func WithGetOpts(ops ...opts.Opt[InjectorGetOpts]) opts.Opt[GetOpts] {
+	return func(o *GetOpts) {
+		for _, v := range ops {
+			v(&o.InjectorGetOpts)
+		}
+	}
 }
 
 // MustGet same as InjectorGet but panics if an error is encountered.
@@ -62,7 +78,9 @@ func InjectorGet[V any](inj Injector, ops ...opts.Opt[GetOpts]) (out V, err erro
 		key, _ = NewTypeKey[V](out)
 	}
 
-	val, err := inj.Get(key)
+	val, err := inj.Get(key, func(getOpts *InjectorGetOpts) {
+		*getOpts = o.InjectorGetOpts
+	})
 	if err != nil {
 		return out, err
 	}
@@ -74,8 +92,8 @@ func InjectorGet[V any](inj Injector, ops ...opts.Opt[GetOpts]) (out V, err erro
 	}
 }
 
-type AddOpts struct {
-}
+// AddOpts opts for the Add and InjectAdd funcs. All opts for the Injector.Add method are supported.
+type AddOpts = InjectorAddOpts
 
 // Add same as InjectAdd but uses the DefaultInjector.
 func Add[K InjectableKey](key K, val any, opts ...opts.Opt[AddOpts]) {
@@ -83,24 +101,148 @@ func Add[K InjectableKey](key K, val any, opts ...opts.Opt[AddOpts]) {
 }
 
 // InjectAdd adds a value into the inj using a key. If InjectAdd is called on a pre-existing value, it is overwritten.
-func InjectAdd[K InjectableKey](inj Injector, key K, val any, _ ...opts.Opt[AddOpts]) {
-	inj.Add(injectableKeyToKey(key), val)
+// The type of a TypedKey isn't checked here; use AddKey to have the value validated against it.
+func InjectAdd[K InjectableKey](inj Injector, key K, val any, opts ...opts.Opt[AddOpts]) {
+	inj.Add(injectableKeyToKey(key), val, opts...)
+}
+
+// GetAll gets every value within the inj whose type is assignable to T e.g. every value that implements an interface.
+// The values are returned in the order their Keys were added. A value is only included if its type is known before
+// it's constructed so values added via a FactoryFunc or NewFactory are included whereas values added via any other
+// Factory are skipped until they're constructed. When called from within a Factory, every value returned is
+// registered as a dependency of the value being built. Values added afterwards aren't dependencies so the value built
+// isn't invalidated when one assignable to T is added; call Injector.Refresh to rebuild it with them.
+//
+//    checkers, err := axon.GetAll[HealthChecker](inj)
+func GetAll[T any](inj Injector) ([]T, error) {
+	typ := reflect.TypeOf(new(T)).Elem()
+
+	// a Factory can't depend on itself.
+	var self Key
+	if kt, ok := inj.(*keyTracker); ok {
+		self = kt.key
+	}
+
+	var keys []Key
+	if i := internalInjector(inj); i != nil {
+		keys = i.assignableKeys(typ, self)
+	} else {
+		inj.Range(func(key Key, info BindingInfo) bool {
+			if info.Type != nil && info.Type.AssignableTo(typ) {
+				keys = append(keys, key)
+			}
+			return true
+		})
+	}
+
+	out := make([]T, 0, len(keys))
+	for _, k := range keys {
+		val, err := inj.Get(k)
+		if err != nil {
+			return nil, err
+		}
+
+		if v, ok := val.(T); ok {
+			out = append(out, v)
+		}
+	}
+
+	return out, nil
+}
+
+// AddKey adds val into the inj using a TypedKey. val must either be a T, a Factory created via NewFactory that builds a
+// T, or any other Factory. If it's not, ErrInvalidType is returned and val is not added.
+func AddKey[T any](inj Injector, key TypedKey[T], val any, opts ...opts.Opt[AddOpts]) error {
+	err := checkType[T](key, val)
+	if err != nil {
+		return err
+	}
+
+	inj.Add(key.Key(), val, opts...)
+	return nil
+}
+
+// GetKey gets the value indexed by a TypedKey from the inj.
+func GetKey[T any](inj Injector, key TypedKey[T]) (out T, err error) {
+	val, err := inj.Get(key.Key())
+	if err != nil {
+		return out, err
+	}
+
+	if val == nil {
+		return out, nil
+	}
+
+	out, ok := val.(T)
+	if !ok {
+		return out, fmt.Errorf("%w: expected %s key to be type %T but got %T", ErrInvalidType, key.String(), out, val)
+	}
+	return out, nil
+}
+
+// MustGetKey same as GetKey but panics if an error is encountered.
+func MustGetKey[T any](inj Injector, key TypedKey[T]) T {
+	out, err := GetKey(inj, key)
+	if err != nil {
+		panic(err)
+	}
+	return out
 }
 
 func NewProvider[T any](val T) *Provider[T] {
 	return &Provider[T]{val: val}
 }
 
+// checkType returns ErrInvalidType if val can't be the value for a TypedKey[T].
+func checkType[T any](key TypedKey[T], val any) error {
+	typ := reflect.TypeOf(new(T)).Elem()
+	var valType reflect.Type
+	switch v := val.(type) {
+	case T:
+		return nil
+	case internalFactory:
+		valType = v.GetType()
+	case Factory:
+		// the type can't be known until the Factory is built.
+		return nil
+	case nil:
+		switch typ.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+			return nil
+		}
+	default:
+		valType = reflect.TypeOf(val)
+	}
+
+	if valType == nil {
+		return fmt.Errorf("%w: %s key expects type %s but got nil", ErrInvalidType, key.String(), typ.String())
+	} else if !valType.AssignableTo(typ) {
+		return fmt.Errorf("%w: %s key expects type %s but got %s", ErrInvalidType, key.String(), typ.String(), valType.String())
+	}
+	return nil
+}
+
+// internalInjector returns the injector behind inj or nil if inj is some other implementation of Injector.
+func internalInjector(inj Injector) *injector {
+	switch v := inj.(type) {
+	case *injector:
+		return v
+	case *keyTracker:
+		return v.injector
+	}
+	return nil
+}
+
 func injectableKeyToKey[V InjectableKey](key V) Key {
-	var k Key
-	if s, ok := any(key).(string); ok {
-		if s == "" {
-			k, _ = NewTypeKey[V](key)
-		} else {
-			k = NewKey(s)
+	switch k := any(key).(type) {
+	case Key:
+		return k
+	case keyer:
+		return k.Key()
+	case string:
+		if k == "" {
+			return newTypeKey[V]()
 		}
-	} else {
-		k = any(key).(Key)
 	}
-	return k
+	return NewKey(key)
 }
@@ -2,6 +2,7 @@ package axon
 
 import (
 	"errors"
+	"fmt"
 	"github.com/stretchr/testify/suite"
 	"testing"
 )
@@ -140,6 +141,343 @@ func (p *PublicTestSuite) TestMustGetKeyPanics() {
 	})
 }
 
+func (p *PublicTestSuite) TestTypedKey() {
+	// -- Given
+	//
+	type server struct {
+		Name string `inject:"name"`
+	}
+
+	key := NewTypedKey[string]("name")
+	inj := NewInjector()
+
+	// -- When
+	//
+	err := AddKey(inj, key, "server")
+
+	// -- Then
+	//
+	if p.NoError(err) {
+		p.Equal("server", MustGetKey(inj, key))
+		actual := new(server)
+		p.NoError(inj.Inject(actual))
+		p.Equal("server", actual.Name)
+		p.Equal("name", key.String())
+		p.Equal(NewKey("name"), key.Key())
+	}
+}
+
+func (p *PublicTestSuite) TestTypedKeyFactory() {
+	// -- Given
+	//
+	key := NewTypedKey[fmt.Stringer]("stringer")
+	inj := NewInjector()
+
+	// -- When
+	//
+	err := AddKey(inj, key, NewFactory[Key](func(_ Injector) (Key, error) {
+		return NewKey("built"), nil
+	}))
+	funcErr := AddKey(inj, NewTypedKey[int]("func"), FactoryFunc[string](func(_ Injector) (string, error) {
+		return "1", nil
+	}))
+	untypedErr := AddKey(inj, NewTypedKey[int]("untyped"), &untypedFactory{Val: "1"})
+
+	// -- Then
+	//
+	if p.NoError(err) && p.NoError(untypedErr) {
+		actual, err := GetKey(inj, key)
+		p.NoError(err)
+		p.Equal(NewKey("built"), actual)
+
+		_, err = GetKey(inj, NewTypedKey[int]("untyped"))
+		p.EqualError(err, "invalid type: expected untyped key to be type int but got string")
+	}
+	p.EqualError(funcErr, "invalid type: func key expects type int but got string")
+}
+
+func (p *PublicTestSuite) TestTypedKeyNil() {
+	// -- Given
+	//
+	key := NewTypedKey[*testDep]("dep")
+	inj := NewInjector()
+
+	// -- When
+	//
+	err := AddKey(inj, key, nil)
+	intErr := AddKey(inj, NewTypedKey[int]("int"), nil)
+
+	// -- Then
+	//
+	if p.NoError(err) {
+		actual, err := GetKey(inj, key)
+		p.NoError(err)
+		p.Nil(actual)
+	}
+	p.EqualError(intErr, "invalid type: int key expects type int but got nil")
+}
+
+func (p *PublicTestSuite) TestTypedKeyWrongType() {
+	// -- Given
+	//
+	key := NewTypedKey[int]("port")
+	inj := NewInjector()
+
+	// -- When
+	//
+	err := AddKey(inj, key, "8080")
+	factoryErr := AddKey(inj, key, NewFactory[string](func(_ Injector) (string, error) {
+		return "8080", nil
+	}))
+
+	// -- Then
+	//
+	p.ErrorIs(err, ErrInvalidType)
+	p.EqualError(err, "invalid type: port key expects type int but got string")
+	p.EqualError(factoryErr, "invalid type: port key expects type int but got string")
+	p.Empty(inj.Keys())
+}
+
+func (p *PublicTestSuite) TestGetKeyMissing() {
+	// -- Given
+	//
+	inj := NewInjector()
+
+	// -- When
+	//
+	_, err := GetKey(inj, NewTypedKey[int]("port"))
+
+	// -- Then
+	//
+	p.ErrorIs(err, ErrNotFound)
+	p.Panics(func() {
+		MustGetKey(inj, NewTypedKey[int]("port"))
+	})
+}
+
+func (p *PublicTestSuite) TestNonStringKeys() {
+	// -- Given
+	//
+	type point struct {
+		X, Y int
+	}
+
+	Add(serviceID(1), "service")
+	Add(1, "int")
+	Add("1", "string")
+	Add(point{X: 1, Y: 2}, "point")
+
+	// -- When
+	//
+	service, err := Get[string](WithKey(serviceID(1)))
+
+	// -- Then
+	//
+	if p.NoError(err) {
+		p.Equal("service", service)
+		p.Equal("int", MustGet[string](WithKey(1)))
+		p.Equal("string", MustGet[string](WithKey("1")))
+		p.Equal("string", MustGet[string](WithKey(NewKey("1"))))
+		p.Equal("point", MustGet[string](WithKey(point{X: 1, Y: 2})))
+		p.Equal("service-1", NewKey(serviceID(1)).String())
+		p.Equal("{1 2}", NewKey(point{X: 1, Y: 2}).String())
+	}
+}
+
+func (p *PublicTestSuite) TestTypedKeyInjectable() {
+	// -- Given
+	//
+	key := NewTypedKey[int]("port")
+
+	// -- When
+	//
+	Add(key, 8080)
+
+	// -- Then
+	//
+	p.Equal(8080, MustGet[int](WithKey(key)))
+	p.Equal(8080, MustGetKey(DefaultInjector, key))
+}
+
+func (p *PublicTestSuite) TestTypeKeysSameName() {
+	// -- Given
+	//
+	first := func() Key {
+		type dup int
+		key, _ := NewTypeKey[dup](0)
+		return key
+	}()
+	second := func() Key {
+		type dup int
+		key, _ := NewTypeKey[dup](0)
+		return key
+	}()
+
+	// -- When
+	//
+	DefaultInjector.Add(first, 1)
+	DefaultInjector.Add(second, 2)
+
+	// -- Then
+	//
+	p.Equal(first.String(), second.String())
+	p.NotEqual(first, second)
+	p.Len(DefaultInjector.Keys(), 2)
+}
+
+type serviceID int
+
+func (s serviceID) String() string {
+	return fmt.Sprintf("service-%d", int(s))
+}
+
+func (p *PublicTestSuite) TestGetAll() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("a"), &checker{Name: "a"})
+	inj.Add(NewKey("other"), 1)
+	inj.Add(NewKey("b"), NewFactory[healthChecker](func(_ Injector) (healthChecker, error) {
+		return &checker{Name: "b"}, nil
+	}))
+	inj.Add(NewKey("func"), FactoryFunc[healthChecker](func(_ Injector) (healthChecker, error) {
+		return &checker{Name: "func"}, nil
+	}))
+	inj.Add(NewKey("nil"), NewFactory[healthChecker](func(_ Injector) (healthChecker, error) {
+		return nil, nil
+	}))
+	inj.Add(NewKey("untyped"), &untypedFactory{Val: &checker{Name: "untyped"}})
+
+	// -- When
+	//
+	actual, err := GetAll[healthChecker](inj)
+	_, _ = inj.Get(NewKey("untyped"))
+	built, builtErr := GetAll[healthChecker](inj)
+
+	// -- Then
+	//
+	if p.NoError(err) && p.NoError(builtErr) {
+		p.Equal([]healthChecker{&checker{Name: "a"}, &checker{Name: "b"}, &checker{Name: "func"}}, actual)
+		p.Equal([]healthChecker{&checker{Name: "a"}, &checker{Name: "b"}, &checker{Name: "func"}, &checker{Name: "untyped"}}, built)
+	}
+}
+
+func (p *PublicTestSuite) TestGetAllOtherInjector() {
+	// -- Given
+	//
+	type otherInjector struct {
+		Injector
+	}
+
+	inj := NewInjector()
+	inj.Add(NewKey("a"), &checker{Name: "a"})
+	inj.Add(NewKey("other"), 1)
+	inj.Add(NewKey("func"), FactoryFunc[healthChecker](func(_ Injector) (healthChecker, error) {
+		return &checker{Name: "func"}, nil
+	}))
+
+	// -- When
+	//
+	actual, err := GetAll[healthChecker](otherInjector{Injector: inj})
+
+	// -- Then
+	//
+	if p.NoError(err) {
+		p.Equal([]healthChecker{&checker{Name: "a"}, &checker{Name: "func"}}, actual)
+	}
+}
+
+func (p *PublicTestSuite) TestGetAllDependencies() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("a"), &checker{Name: "a"})
+	inj.Add(NewKey("all"), NewFactory[healthChecker](func(inj Injector) (healthChecker, error) {
+		all, err := GetAll[healthChecker](inj)
+		return &checker{Name: fmt.Sprint(len(all))}, err
+	}))
+	inj.Add(NewKey("b"), &checker{Name: "b"})
+
+	// -- When
+	//
+	actual, err := inj.Get(NewKey("all"))
+
+	// -- Then
+	//
+	if p.NoError(err) {
+		p.Equal(&checker{Name: "2"}, actual)
+		info, _ := inj.Describe(NewKey("all"))
+		p.Equal([]Key{NewKey("a"), NewKey("b")}, info.Dependencies)
+	}
+}
+
+func (p *PublicTestSuite) TestGetAllAddedAfter() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("a"), &checker{Name: "a"})
+	inj.Add(NewKey("all"), NewFactory[healthChecker](func(inj Injector) (healthChecker, error) {
+		all, err := GetAll[healthChecker](inj)
+		return &checker{Name: fmt.Sprint(len(all))}, err
+	}))
+	_, err := inj.Get(NewKey("all"))
+	p.Require().NoError(err)
+
+	// -- When
+	//
+	inj.Add(NewKey("b"), &checker{Name: "b"})
+	stale, _ := inj.Get(NewKey("all"))
+	refreshErr := inj.Refresh(NewKey("all"))
+	refreshed, err := inj.Get(NewKey("all"))
+
+	// -- Then
+	//
+	p.Equal(&checker{Name: "1"}, stale)
+	p.NoError(refreshErr)
+	if p.NoError(err) {
+		p.Equal(&checker{Name: "2"}, refreshed)
+	}
+}
+
+func (p *PublicTestSuite) TestGetAllError() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("a"), NewFactory[healthChecker](func(_ Injector) (healthChecker, error) {
+		return nil, errors.New("unhealthy")
+	}))
+
+	// -- When
+	//
+	actual, err := GetAll[healthChecker](inj)
+
+	// -- Then
+	//
+	p.EqualError(err, "unhealthy")
+	p.Nil(actual)
+}
+
+type healthChecker interface {
+	Check() error
+}
+
+// untypedFactory is a Factory whose type isn't known until it's built.
+type untypedFactory struct {
+	Val any
+}
+
+func (u *untypedFactory) Build(_ Injector) (any, error) {
+	return u.Val, nil
+}
+
+type checker struct {
+	Name string
+}
+
+func (c *checker) Check() error {
+	return nil
+}
+
 func TestPublicTestSuite(t *testing.T) {
 	suite.Run(t, new(PublicTestSuite))
 }
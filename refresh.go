@@ -0,0 +1,118 @@
+package axon
+
+import (
+	"fmt"
+	"github.com/eddieowens/axon/internal/mirror"
+	"github.com/eddieowens/axon/opts"
+	"reflect"
+)
+
+// InjectorRefreshOpts opts for the Injector.Refresh method.
+type InjectorRefreshOpts struct {
+	// See WithRefreshDependents.
+	Dependents bool
+
+	// See WithEagerRefresh.
+	Eager bool
+}
+
+// WithRefreshDependents refreshes every value that depends on the Key, either directly or transitively, along with the
+// value itself. Values added WithNoCascade are skipped along with anything that's only reachable through them.
+func WithRefreshDependents() opts.Opt[InjectorRefreshOpts] {
+	return func(opts *InjectorRefreshOpts) {
+		opts.Dependents = true
+	}
+}
+
+// WithEagerRefresh constructs the refreshed values immediately rather than on the next call to Get. If constructing any
+// of the values fails, the first error is returned.
+//
+//    err := inj.Refresh(axon.NewKey("credentials"), axon.WithRefreshDependents(), axon.WithEagerRefresh())
+func WithEagerRefresh() opts.Opt[InjectorRefreshOpts] {
+	return func(opts *InjectorRefreshOpts) {
+		opts.Eager = true
+	}
+}
+
+func (i *injector) Refresh(key Key, ops ...opts.Opt[InjectorRefreshOpts]) error {
+	o := opts.ApplyOpts(&InjectorRefreshOpts{}, ops...)
+	v := i.lookup(key)
+	if v == nil {
+		return ErrNotFound
+	}
+
+	refreshed := []Key{}
+	if o.Dependents {
+		refreshed = i.invalidateDependents(key, InvalidationRefreshed)
+	}
+	_ = i.destroy(key, v, InvalidationRefreshed, key)
+	refreshed = append(refreshed, key)
+
+	i.Lock.Lock()
+	if i.Refreshed == nil {
+		i.Refreshed = map[any]bool{}
+	}
+	i.Refreshed[key] = true
+	i.Lock.Unlock()
+
+	if !o.Eager {
+		return nil
+	}
+
+	// values were destroyed dependents first so construct them in reverse.
+	var firstErr error
+	for j := len(refreshed) - 1; j >= 0; j-- {
+		_, err := i.get(refreshed[j], nil)
+		if err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("failed to refresh %v: %w", refreshed[j], err)
+		}
+	}
+
+	return firstErr
+}
+
+// pushToDependents sets val on the MutableValue fields of every constructed value which directly depends on the key.
+// Dependents that are being constructed again get the value through injection instead.
+func (i *injector) pushToDependents(key Key, val any) {
+	for _, dependent := range i.dependents(key) {
+		v := i.lookup(dependent.(Key))
+		if v == nil {
+			continue
+		}
+
+		// dependents that aren't constructed, or were invalidated in the meantime, are never constructed here.
+		con := v.GetContainer()
+		if con == nil {
+			continue
+		}
+
+		strct := mirror.StripPtrs(reflect.ValueOf(con.GetValue()))
+		if strct.Kind() != reflect.Struct {
+			continue
+		}
+
+		for j := 0; j < strct.NumField(); j++ {
+			field := strct.Field(j)
+			if tagKey(strct.Type().Field(j).Tag.Get(InjectTag), field) != key {
+				continue
+			}
+
+			if field.Kind() == reflect.Ptr && field.IsNil() {
+				continue
+			}
+
+			mut := getMutableValue(field)
+			if mut != nil && !isSame(mut, val) {
+				_ = mut.SetValue(val)
+			}
+		}
+	}
+}
+
+// isSame returns true if a and b are the same value. Values that can't be compared are never the same.
+func isSame(a, b any) bool {
+	if reflect.TypeOf(a) != reflect.TypeOf(b) || !reflect.TypeOf(a).Comparable() {
+		return false
+	}
+	return a == b
+}
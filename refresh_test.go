@@ -0,0 +1,284 @@
+package axon
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type RefreshTestSuite struct {
+	suite.Suite
+}
+
+func (r *RefreshTestSuite) TestRefresh() {
+	// -- Given
+	//
+	builds := 0
+	inj := NewInjector()
+	inj.Add(NewKey("creds"), NewFactory[string](func(_ Injector) (string, error) {
+		builds++
+		return fmt.Sprint(builds), nil
+	}))
+	_, err := inj.Get(NewKey("creds"))
+	r.Require().NoError(err)
+
+	// -- When
+	//
+	err = inj.Refresh(NewKey("creds"))
+
+	// -- Then
+	//
+	r.NoError(err)
+	info, _ := inj.Describe(NewKey("creds"))
+	r.False(info.Instantiated)
+	r.Equal(1, builds)
+
+	actual, err := inj.Get(NewKey("creds"))
+	if r.NoError(err) {
+		r.Equal("2", actual)
+	}
+}
+
+func (r *RefreshTestSuite) TestRefreshEagerDependents() {
+	// -- Given
+	//
+	type service struct {
+		Creds string `inject:"creds"`
+	}
+	type server struct {
+		Service *service `inject:"service"`
+	}
+
+	builds := 0
+	events := make([]string, 0)
+	inj := NewInjector(WithObserver(ObserverFunc(func(e Event) {
+		if e.Type == EventInvalidated {
+			events = append(events, fmt.Sprintf("%s %s %s", e.Key, e.Reason, e.Cause))
+		}
+	})))
+	inj.Add(NewKey("creds"), NewFactory[string](func(_ Injector) (string, error) {
+		builds++
+		return fmt.Sprint(builds), nil
+	}))
+	inj.Add(NewKey("service"), NewFactory[*service](func(_ Injector) (*service, error) {
+		return new(service), nil
+	}))
+	inj.Add(NewKey("server"), new(server))
+	_, err := inj.Get(NewKey("server"))
+	r.Require().NoError(err)
+
+	// -- When
+	//
+	err = inj.Refresh(NewKey("creds"), WithRefreshDependents(), WithEagerRefresh())
+
+	// -- Then
+	//
+	r.NoError(err)
+	r.Equal([]string{"server Refreshed creds", "service Refreshed creds", "creds Refreshed creds"}, events)
+	for _, k := range []string{"creds", "service", "server"} {
+		info, _ := inj.Describe(NewKey(k))
+		r.True(info.Instantiated, k)
+	}
+	actual, _ := inj.Get(NewKey("server"))
+	r.Equal("2", actual.(*server).Service.Creds)
+}
+
+func (r *RefreshTestSuite) TestRefreshMutableValue() {
+	// -- Given
+	//
+	builds := 0
+	inj := NewInjector()
+	inj.Add(NewKey("creds"), NewFactory[*Provider[string]](func(_ Injector) (*Provider[string], error) {
+		builds++
+		return NewProvider(fmt.Sprint(builds)), nil
+	}))
+	given, err := inj.Get(NewKey("creds"))
+	r.Require().NoError(err)
+
+	// -- When
+	//
+	err = inj.Refresh(NewKey("creds"), WithEagerRefresh())
+
+	// -- Then
+	//
+	r.NoError(err)
+	r.Equal("2", given.(*Provider[string]).Get())
+	actual, _ := inj.Get(NewKey("creds"))
+	r.Same(given, actual)
+}
+
+func (r *RefreshTestSuite) TestRefreshPushToDependents() {
+	// -- Given
+	//
+	type service struct {
+		Name    string
+		Creds   *Provider[string] `inject:"creds"`
+		Missing *Provider[string] `inject:"creds"`
+	}
+
+	builds := 0
+	inj := NewInjector()
+	inj.Add(NewKey("creds"), NewFactory[string](func(_ Injector) (string, error) {
+		builds++
+		return fmt.Sprint(builds), nil
+	}))
+	inj.Add(NewKey("service"), new(service), WithNoCascade())
+	inj.Add(NewKey("length"), NewFactory[int](func(inj Injector) (int, error) {
+		creds, err := inj.Get(NewKey("creds"))
+		return len(creds.(string)), err
+	}))
+	inj.Add(NewKey("unbuilt"), new(service))
+
+	svc, err := inj.Get(NewKey("service"))
+	r.Require().NoError(err)
+	_, err = inj.Get(NewKey("length"))
+	r.Require().NoError(err)
+	svc.(*service).Missing = nil
+
+	// -- When
+	//
+	err = inj.Refresh(NewKey("creds"))
+	_, _ = inj.Get(NewKey("creds"))
+
+	// -- Then
+	//
+	r.NoError(err)
+	r.Equal("2", svc.(*service).Creds.Get())
+	r.Nil(svc.(*service).Missing)
+	info, _ := inj.Describe(NewKey("service"))
+	r.True(info.Instantiated)
+}
+
+func (r *RefreshTestSuite) TestRefreshProviderValue() {
+	// -- Given
+	//
+	type service struct {
+		Creds *Provider[string] `inject:"creds"`
+	}
+
+	given := NewProvider("creds")
+	inj := NewInjector()
+	inj.Add(NewKey("creds"), given)
+	inj.Add(NewKey("service"), new(service))
+	svc, err := inj.Get(NewKey("service"))
+	r.Require().NoError(err)
+
+	// -- When
+	//
+	err = inj.Refresh(NewKey("creds"), WithEagerRefresh())
+
+	// -- Then
+	//
+	r.NoError(err)
+	r.Same(given, svc.(*service).Creds)
+	r.Equal("creds", given.Get())
+}
+
+func (r *RefreshTestSuite) TestRefreshEagerError() {
+	// -- Given
+	//
+	fail := false
+	inj := NewInjector()
+	inj.Add(NewKey("creds"), NewFactory[string](func(_ Injector) (string, error) {
+		if fail {
+			return "", errors.New("rotated")
+		}
+		return "creds", nil
+	}))
+	_, err := inj.Get(NewKey("creds"))
+	r.Require().NoError(err)
+	fail = true
+
+	// -- When
+	//
+	err = inj.Refresh(NewKey("creds"), WithEagerRefresh())
+
+	// -- Then
+	//
+	r.EqualError(err, "failed to refresh creds: rotated")
+}
+
+func (r *RefreshTestSuite) TestRefreshMutableValueError() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("creds"), NewFactory[*immutableValue](func(_ Injector) (*immutableValue, error) {
+		return new(immutableValue), nil
+	}))
+	_, err := inj.Get(NewKey("creds"))
+	r.Require().NoError(err)
+
+	// -- When
+	//
+	err = inj.Refresh(NewKey("creds"), WithEagerRefresh())
+
+	// -- Then
+	//
+	r.ErrorIs(err, ErrInvalidType)
+}
+
+func (r *RefreshTestSuite) TestRefreshNotFound() {
+	// -- Given
+	//
+	inj := NewInjector()
+
+	// -- When
+	//
+	err := inj.Refresh(NewKey("creds"))
+
+	// -- Then
+	//
+	r.ErrorIs(err, ErrNotFound)
+}
+
+func (r *RefreshTestSuite) TestRefreshCleared() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("removed"), 1)
+	inj.Add(NewKey("restored"), 2)
+	inj.Add(NewKey("shutdown"), 3)
+	snapshot := inj.Snapshot()
+	internal := inj.(*injector)
+
+	// -- When
+	//
+	r.Require().NoError(inj.Refresh(NewKey("removed")))
+	r.Require().NoError(inj.Remove(NewKey("removed")))
+	inj.Add(NewKey("removed"), 1)
+	removed := internal.Refreshed[NewKey("removed")]
+
+	r.Require().NoError(inj.Refresh(NewKey("restored")))
+	inj.Restore(snapshot)
+	restored := internal.Refreshed[NewKey("restored")]
+
+	r.Require().NoError(inj.Refresh(NewKey("shutdown")))
+	r.Require().NoError(inj.Shutdown())
+	shutdown := internal.Refreshed[NewKey("shutdown")]
+
+	// -- Then
+	//
+	r.False(removed)
+	r.False(restored)
+	r.False(shutdown)
+}
+
+func (r *RefreshTestSuite) TestIsSame() {
+	r.False(isSame([]int{1}, []int{1}))
+	r.False(isSame(1, "1"))
+	r.True(isSame(1, 1))
+}
+
+type immutableValue struct {
+	// prevents zero-sized allocations from sharing an address.
+	_ int
+}
+
+func (i *immutableValue) SetValue(_ any) error {
+	return ErrInvalidType
+}
+
+func TestRefreshTestSuite(t *testing.T) {
+	suite.Run(t, new(RefreshTestSuite))
+}
@@ -0,0 +1,60 @@
+package axon
+
+import (
+	"github.com/eddieowens/axon/opts"
+	"time"
+)
+
+// RetryPolicy controls what happens when constructing a value fails. The zero value makes a single attempt per call
+// to Get and doesn't cache the error so the next call to Get tries again.
+type RetryPolicy struct {
+	// The maximum number of attempts made to construct the value within a single call to Get. Values less than 1 are
+	// treated as 1.
+	MaxAttempts int
+
+	// Returns how long to wait after the failed attempt before trying again. attempt starts at 1. If nil, there is no
+	// wait. See ConstantBackoff and ExponentialBackoff.
+	Backoff func(attempt int) time.Duration
+
+	// If true, once all attempts fail the error is returned by every subsequent call to Get without trying again. The
+	// error is cleared when the value is invalidated e.g. via Injector.Add or Injector.Refresh.
+	CacheError bool
+}
+
+// WithRetryPolicy sets the RetryPolicy for the value being added.
+//
+//    inj.Add(axon.NewKey("db"), dbFactory, axon.WithRetryPolicy(axon.RetryPolicy{
+//        MaxAttempts: 5,
+//        Backoff:     axon.ExponentialBackoff(100*time.Millisecond, 5*time.Second),
+//    }))
+func WithRetryPolicy(p RetryPolicy) opts.Opt[InjectorAddOpts] {
+	return func(opts *InjectorAddOpts) {
+		opts.RetryPolicy = p
+	}
+}
+
+// ConstantBackoff waits d between every attempt.
+func ConstantBackoff(d time.Duration) func(attempt int) time.Duration {
+	return func(_ int) time.Duration {
+		return d
+	}
+}
+
+// ExponentialBackoff waits initial after the first attempt and doubles the wait after every subsequent attempt up to
+// max.
+func ExponentialBackoff(initial, max time.Duration) func(attempt int) time.Duration {
+	return func(attempt int) time.Duration {
+		d := initial
+		for i := 1; i < attempt && d < max; i++ {
+			d *= 2
+		}
+
+		if d > max {
+			return max
+		}
+		return d
+	}
+}
+
+// sleep is swapped out within tests.
+var sleep = time.Sleep
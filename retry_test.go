@@ -0,0 +1,209 @@
+package axon
+
+import (
+	"errors"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type RetryTestSuite struct {
+	suite.Suite
+	slept []time.Duration
+}
+
+func (r *RetryTestSuite) SetupTest() {
+	r.slept = make([]time.Duration, 0)
+	sleep = func(d time.Duration) {
+		r.slept = append(r.slept, d)
+	}
+}
+
+func (r *RetryTestSuite) TearDownTest() {
+	sleep = time.Sleep
+}
+
+// failingFactory fails the first n builds.
+func failingFactory(n int, builds *int) Factory {
+	return NewFactory[string](func(_ Injector) (string, error) {
+		*builds++
+		if *builds <= n {
+			return "", errors.New("not ready")
+		}
+		return "ready", nil
+	})
+}
+
+func (r *RetryTestSuite) TestRetryableByDefault() {
+	// -- Given
+	//
+	builds := 0
+	inj := NewInjector()
+	inj.Add(NewKey("db"), failingFactory(1, &builds))
+	_, err := inj.Get(NewKey("db"))
+	r.Require().EqualError(err, "not ready")
+
+	// -- When
+	//
+	actual, err := inj.Get(NewKey("db"))
+
+	// -- Then
+	//
+	if r.NoError(err) {
+		r.Equal("ready", actual)
+		r.Equal(2, builds)
+		r.Empty(r.slept)
+	}
+}
+
+func (r *RetryTestSuite) TestMaxAttempts() {
+	// -- Given
+	//
+	builds := 0
+	inj := NewInjector()
+	inj.Add(NewKey("db"), failingFactory(2, &builds), WithRetryPolicy(RetryPolicy{
+		MaxAttempts: 3,
+		Backoff:     ConstantBackoff(time.Second),
+	}))
+
+	// -- When
+	//
+	actual, err := inj.Get(NewKey("db"))
+
+	// -- Then
+	//
+	if r.NoError(err) {
+		r.Equal("ready", actual)
+		r.Equal(3, builds)
+		r.Equal([]time.Duration{time.Second, time.Second}, r.slept)
+	}
+}
+
+func (r *RetryTestSuite) TestMaxAttemptsExhausted() {
+	// -- Given
+	//
+	builds := 0
+	inj := NewInjector()
+	inj.Add(NewKey("db"), failingFactory(5, &builds), WithRetryPolicy(RetryPolicy{MaxAttempts: 2}))
+
+	// -- When
+	//
+	_, err := inj.Get(NewKey("db"))
+
+	// -- Then
+	//
+	r.EqualError(err, "not ready")
+	r.Equal(2, builds)
+	r.Empty(r.slept)
+}
+
+func (r *RetryTestSuite) TestCacheError() {
+	// -- Given
+	//
+	builds := 0
+	inj := NewInjector()
+	inj.Add(NewKey("db"), failingFactory(1, &builds), WithRetryPolicy(RetryPolicy{CacheError: true}))
+	_, err := inj.Get(NewKey("db"))
+	r.Require().Error(err)
+
+	// -- When
+	//
+	_, cachedErr := inj.Get(NewKey("db"))
+	refreshErr := inj.Refresh(NewKey("db"))
+	actual, err := inj.Get(NewKey("db"))
+
+	// -- Then
+	//
+	r.EqualError(cachedErr, "not ready")
+	r.NoError(refreshErr)
+	if r.NoError(err) {
+		r.Equal("ready", actual)
+		r.Equal(2, builds)
+	}
+}
+
+func (r *RetryTestSuite) TestCacheErrorOverwritten() {
+	// -- Given
+	//
+	builds := 0
+	inj := NewInjector()
+	inj.Add(NewKey("db"), failingFactory(1, &builds), WithRetryPolicy(RetryPolicy{CacheError: true}))
+	_, err := inj.Get(NewKey("db"))
+	r.Require().Error(err)
+
+	// -- When
+	//
+	inj.Add(NewKey("db"), failingFactory(1, &builds))
+	actual, err := inj.Get(NewKey("db"))
+
+	// -- Then
+	//
+	if r.NoError(err) {
+		r.Equal("ready", actual)
+	}
+}
+
+func (r *RetryTestSuite) TestCacheErrorRestored() {
+	// -- Given
+	//
+	builds := 0
+	inj := NewInjector()
+	inj.Add(NewKey("db"), failingFactory(1, &builds), WithRetryPolicy(RetryPolicy{CacheError: true}))
+	_, err := inj.Get(NewKey("db"))
+	r.Require().Error(err)
+	snapshot := inj.Snapshot()
+	r.Require().NoError(inj.Refresh(NewKey("db")))
+
+	// -- When
+	//
+	inj.Restore(snapshot)
+	_, err = inj.Get(NewKey("db"))
+
+	// -- Then
+	//
+	r.EqualError(err, "not ready")
+	r.Equal(1, builds)
+}
+
+func (r *RetryTestSuite) TestFailedDependency() {
+	// -- Given
+	//
+	type server struct {
+		DB string `inject:"db"`
+	}
+
+	builds := 0
+	inj := NewInjector()
+	inj.Add(NewKey("db"), failingFactory(1, &builds))
+	inj.Add(NewKey("server"), new(server))
+	_, err := inj.Get(NewKey("server"))
+	r.Require().Error(err)
+
+	// -- When
+	//
+	actual, err := inj.Get(NewKey("server"))
+
+	// -- Then
+	//
+	if r.NoError(err) {
+		r.Equal("ready", actual.(*server).DB)
+	}
+}
+
+func (r *RetryTestSuite) TestExponentialBackoff() {
+	// -- Given
+	//
+	given := ExponentialBackoff(time.Second, 5*time.Second)
+
+	// -- When
+	//
+	actual := []time.Duration{given(1), given(2), given(3), given(4), given(10)}
+
+	// -- Then
+	//
+	r.Equal([]time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}, actual)
+}
+
+func TestRetryTestSuite(t *testing.T) {
+	suite.Run(t, new(RetryTestSuite))
+}
@@ -0,0 +1,100 @@
+package axon
+
+import (
+	"github.com/eddieowens/axon/internal/depgraph"
+)
+
+// Snapshot is a point-in-time copy of all the bindings within an Injector. See Injector.Snapshot.
+type Snapshot struct {
+	graph depgraph.DoubleMap[any, containerProvider[any]]
+
+	// The values held by MutableValues at the time of the Snapshot. MutableValues are updated in place by Injector.Add so
+	// their values need to be tracked separately from the bindings themselves.
+	mutableValues map[any]any
+}
+
+// valueSnapshotter is implemented by MutableValues whose current value can be captured and later handed back to
+// MutableValue.SetValue.
+type valueSnapshotter interface {
+	snapshotValue() any
+}
+
+func (i *injector) Snapshot() Snapshot {
+	i.Lock.RLock()
+	s := Snapshot{
+		graph:         cloneGraph(i.DepGraph),
+		mutableValues: map[any]any{},
+	}
+	i.Lock.RUnlock()
+
+	s.graph.Range(func(key any, val containerProvider[any]) bool {
+		if snap, ok := val.GetValue().(valueSnapshotter); ok && val.IsInstantiated() {
+			s.mutableValues[key] = snap.snapshotValue()
+		}
+		return true
+	})
+
+	return s
+}
+
+func (i *injector) Restore(s Snapshot) {
+	if s.graph == nil {
+		return
+	}
+
+	graph := cloneGraph(s.graph)
+	i.Lock.Lock()
+	dropped := i.DepGraph
+	i.DepGraph = graph
+	i.Refreshed = nil
+	i.Lock.Unlock()
+
+	for k, v := range s.mutableValues {
+		_ = graph.Get(k).GetValue().(MutableValue).SetValue(v)
+	}
+
+	i.destroyDropped(dropped, graph)
+}
+
+// destroyDropped destroys every value within the dropped graph that was constructed but isn't held by the restored
+// graph e.g. values constructed after the Snapshot was taken. Dependents are destroyed before the values they depend
+// on.
+func (i *injector) destroyDropped(dropped, restored depgraph.DoubleMap[any, containerProvider[any]]) {
+	visited := map[any]bool{}
+
+	var destroy func(key any)
+	destroy = func(key any) {
+		if visited[key] {
+			return
+		}
+		visited[key] = true
+
+		for _, dependent := range dropped.GetDependents(key) {
+			destroy(dependent)
+		}
+
+		v, ok := dropped.Lookup(key)
+		if !ok || v.GetContainer() == nil {
+			return
+		}
+
+		if r, ok := restored.Lookup(key); ok && r.GetContainer() == v.GetContainer() {
+			return
+		}
+		_ = i.destroy(key.(Key), v, InvalidationRestored, Key{})
+	}
+
+	dropped.Range(func(key any, _ containerProvider[any]) bool {
+		destroy(key)
+		return true
+	})
+}
+
+func cloneGraph(graph depgraph.DoubleMap[any, containerProvider[any]]) depgraph.DoubleMap[any, containerProvider[any]] {
+	out := graph.Clone()
+	graph.Range(func(key any, val containerProvider[any]) bool {
+		out.Add(key, val.Clone())
+		return true
+	})
+	return out
+}
@@ -0,0 +1,175 @@
+package axon
+
+import (
+	"github.com/eddieowens/axon/internal/depgraph"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type SnapshotTestSuite struct {
+	suite.Suite
+}
+
+func (s *SnapshotTestSuite) TestRestoreOverwritten() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("S"), "original")
+	inj.Add(NewKey("dep"), NewFactory[*testDep](func(_ Injector) (*testDep, error) {
+		return new(testDep), nil
+	}))
+	before, _ := inj.Get(NewKey("dep"))
+
+	snapshot := inj.Snapshot()
+	inj.Add(NewKey("S"), "override")
+	inj.Add(NewKey("dep"), &testDep{})
+	inj.Add(NewKey("new"), 1)
+
+	// -- When
+	//
+	inj.Restore(snapshot)
+
+	// -- Then
+	//
+	actual, err := inj.Get(NewKey("dep"))
+	if s.NoError(err) {
+		s.Same(before, actual)
+		s.Equal(&testDep{S: "original"}, actual)
+	}
+
+	_, err = inj.Get(NewKey("new"))
+	s.ErrorIs(err, ErrNotFound)
+}
+
+func (s *SnapshotTestSuite) TestRestoreDestroysNew() {
+	// -- Given
+	//
+	values := map[string]*destroyable{}
+	factory := func(name string) Factory {
+		return NewFactory[*destroyable](func(inj Injector) (*destroyable, error) {
+			if name == "dependent" {
+				_, _ = inj.Get(NewKey("added"))
+			}
+			values[name] = new(destroyable)
+			return values[name], nil
+		})
+	}
+
+	inj := NewInjector()
+	inj.Add(NewKey("before"), factory("before"))
+	inj.Add(NewKey("unconstructed"), factory("unconstructed"))
+	_, _ = inj.Get(NewKey("before"))
+	snapshot := inj.Snapshot()
+
+	inj.Add(NewKey("added"), factory("added"))
+	inj.Add(NewKey("dependent"), factory("dependent"))
+	for _, k := range []string{"unconstructed", "dependent"} {
+		_, err := inj.Get(NewKey(k))
+		s.Require().NoError(err)
+	}
+
+	var invalidated []Key
+	inj.AddObserver(ObserverFunc(func(e Event) {
+		if e.Type == EventInvalidated && e.Reason == InvalidationRestored {
+			invalidated = append(invalidated, e.Key)
+		}
+	}))
+
+	// -- When
+	//
+	inj.Restore(snapshot)
+
+	// -- Then
+	//
+	s.Equal([]Key{NewKey("unconstructed"), NewKey("dependent"), NewKey("added")}, invalidated)
+	s.False(values["before"].Destroyed)
+	for _, k := range []string{"unconstructed", "added", "dependent"} {
+		s.True(values[k].Destroyed, k)
+	}
+
+	actual, err := inj.Get(NewKey("before"))
+	if s.NoError(err) {
+		s.Same(values["before"], actual)
+	}
+}
+
+func (s *SnapshotTestSuite) TestRestoreTwice() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("key"), 1)
+	snapshot := inj.Snapshot()
+
+	inj.Add(NewKey("key"), 2)
+	inj.Restore(snapshot)
+	inj.Add(NewKey("key"), 3)
+
+	// -- When
+	//
+	inj.Restore(snapshot)
+
+	// -- Then
+	//
+	actual, _ := inj.Get(NewKey("key"))
+	s.Equal(1, actual)
+}
+
+func (s *SnapshotTestSuite) TestRestoreProvider() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("secret"), NewProvider("original"))
+	secret, _ := InjectorGet[*Provider[string]](inj, WithKey("secret"))
+	snapshot := inj.Snapshot()
+
+	inj.Add(NewKey("secret"), NewProvider("override"))
+	s.Equal("override", secret.Get())
+
+	// -- When
+	//
+	inj.Restore(snapshot)
+
+	// -- Then
+	//
+	s.Equal("original", secret.Get())
+}
+
+func (s *SnapshotTestSuite) TestRestoreDependencies() {
+	// -- Given
+	//
+	inj := &injector{DepGraph: depgraph.NewDoubleMap[containerProvider[any]]()}
+	inj.Add(NewKey("S"), "s")
+	inj.Add(NewKey("dep"), new(testDep))
+	_, _ = inj.Get(NewKey("dep"))
+	snapshot := inj.Snapshot()
+
+	inj.Add(NewKey("dep"), "dep")
+
+	// -- When
+	//
+	inj.Restore(snapshot)
+
+	// -- Then
+	//
+	s.Equal([]any{NewKey("S")}, inj.DepGraph.GetDependencies(NewKey("dep")))
+}
+
+func (s *SnapshotTestSuite) TestRestoreEmpty() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("key"), 1)
+
+	// -- When
+	//
+	inj.Restore(Snapshot{})
+
+	// -- Then
+	//
+	actual, _ := inj.Get(NewKey("key"))
+	s.Equal(1, actual)
+}
+
+func TestSnapshotTestSuite(t *testing.T) {
+	suite.Run(t, new(SnapshotTestSuite))
+}
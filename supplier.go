@@ -0,0 +1,94 @@
+package axon
+
+import (
+	"fmt"
+	"reflect"
+)
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// isSupplierType returns true if typ is a func() T or a func() (T, error).
+func isSupplierType(typ reflect.Type) bool {
+	if typ.Kind() != reflect.Func || typ.NumIn() != 0 {
+		return false
+	}
+
+	switch typ.NumOut() {
+	case 1:
+		return true
+	case 2:
+		return typ.Out(1) == errorType
+	}
+	return false
+}
+
+// supplierKey returns the Key a func typed field supplies values for. Fields tagged with a name supply the value for
+// that name and fields tagged with the type tag supply the type key of the func's return type. If the bound value can
+// be set on the field as is, e.g. the value is itself a func, or the field shouldn't be injected, an empty Key is
+// returned.
+func (i *injector) supplierKey(key Key, tag string, field reflect.Value) Key {
+	parsed := parseTag(tag)
+	if parsed == nil || !isSupplierType(field.Type()) {
+		return Key{}
+	}
+
+	var k Key
+	if parsed.Name != "" {
+		k = NewKey(parsed.Name)
+		v := i.lookup(k)
+		if v == nil || v.GetType() == nil || v.GetType().AssignableTo(field.Type()) {
+			return Key{}
+		}
+	} else if parsed.InjectType {
+		if i.lookup(newReflectKey(field)) != nil {
+			return Key{}
+		}
+		k = newKeyOfType(field.Type().Out(0))
+		if i.lookup(k) == nil {
+			return Key{}
+		}
+	} else {
+		return Key{}
+	}
+
+	if !field.IsZero() && !i.dependsOn(key, k) {
+		return Key{}
+	}
+
+	return k
+}
+
+// injectSupplier sets a func on the field which gets the value indexed by depKey on every call. A func() T panics if
+// getting the value fails while a func() (T, error) returns the error. The func is safe to call concurrently as it only
+// touches the injector through its locked lookups.
+func (i *injector) injectSupplier(key Key, field reflect.Value, strctField reflect.StructField, depKey Key) error {
+	if !field.CanSet() {
+		return fmt.Errorf("%w: field %s is not settable", ErrInvalidField, strctField.Name)
+	}
+
+	typ := field.Type()
+	field.Set(reflect.MakeFunc(typ, func(_ []reflect.Value) []reflect.Value {
+		out := reflect.New(typ.Out(0)).Elem()
+		con, err := i.resolveValue(depKey, nil)
+		if err == nil {
+			err = i.setReflectVal(out, con, depKey)
+		}
+
+		if typ.NumOut() == 1 {
+			if err != nil {
+				panic(err)
+			}
+			return []reflect.Value{out}
+		}
+
+		errVal := reflect.New(errorType).Elem()
+		if err != nil {
+			out = reflect.New(typ.Out(0)).Elem()
+			errVal.Set(reflect.ValueOf(err))
+		}
+		return []reflect.Value{out, errVal}
+	}))
+
+	i.addDependencies(key, depKey)
+	return nil
+}
@@ -0,0 +1,283 @@
+package axon
+
+import (
+	"errors"
+	"github.com/stretchr/testify/suite"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+type SupplierTestSuite struct {
+	suite.Suite
+}
+
+type supplierConn struct {
+	ID int
+}
+
+type supplierPool struct {
+	NewConn   func() (*supplierConn, error) `inject:"conn"`
+	TypedConn func() *supplierConn          `inject:",type"`
+}
+
+func (s *SupplierTestSuite) newInjector() (Injector, *int) {
+	builds := 0
+	inj := NewInjector()
+	inj.Add(NewKey("conn"), NewFactory[*supplierConn](func(_ Injector) (*supplierConn, error) {
+		builds++
+		return &supplierConn{ID: builds}, nil
+	}), WithScope(ScopeTransient))
+	inj.Add(newTypeKey[*supplierConn](), NewFactory[*supplierConn](func(_ Injector) (*supplierConn, error) {
+		builds++
+		return &supplierConn{ID: builds}, nil
+	}), WithScope(ScopeTransient))
+	return inj, &builds
+}
+
+func (s *SupplierTestSuite) TestSupplier() {
+	// -- Given
+	//
+	inj, builds := s.newInjector()
+	inj.Add(NewKey("pool"), new(supplierPool))
+
+	// -- When
+	//
+	actual, err := inj.Get(NewKey("pool"))
+
+	// -- Then
+	//
+	s.Require().NoError(err)
+	s.Equal(0, *builds)
+	pool := actual.(*supplierPool)
+
+	first, err := pool.NewConn()
+	s.Require().NoError(err)
+	second, err := pool.NewConn()
+	s.Require().NoError(err)
+	s.Equal(1, first.ID)
+	s.Equal(2, second.ID)
+	s.Equal(3, pool.TypedConn().ID)
+	s.Equal(4, pool.TypedConn().ID)
+
+	info, _ := inj.Describe(NewKey("pool"))
+	s.ElementsMatch([]Key{NewKey("conn"), newTypeKey[*supplierConn]()}, info.Dependencies)
+}
+
+func (s *SupplierTestSuite) TestSupplierConcurrent() {
+	// -- Given
+	//
+	builds := int32(0)
+	inj := NewInjector()
+	inj.Add(NewKey("id"), 1)
+	inj.Add(NewKey("conn"), NewFactory[*supplierConn](func(inj Injector) (*supplierConn, error) {
+		atomic.AddInt32(&builds, 1)
+		id, err := inj.Get(NewKey("id"))
+		if err != nil {
+			return nil, err
+		}
+		return &supplierConn{ID: id.(int)}, nil
+	}), WithScope(ScopeTransient))
+
+	given := &struct {
+		NewConn func() (*supplierConn, error) `inject:"conn"`
+	}{}
+	s.Require().NoError(inj.Inject(given))
+
+	// -- When
+	//
+	wg := sync.WaitGroup{}
+	errs := make([]error, 10)
+	for j := range errs {
+		wg.Add(1)
+		go func(j int) {
+			defer wg.Done()
+			_, errs[j] = given.NewConn()
+		}(j)
+	}
+	for j := 0; j < 10; j++ {
+		inj.Add(NewKey(j), j)
+		_, _ = inj.Get(NewKey(j))
+		_, _ = inj.Describe(NewKey("conn"))
+	}
+	wg.Wait()
+
+	// -- Then
+	//
+	for _, err := range errs {
+		s.NoError(err)
+	}
+	s.EqualValues(10, builds)
+	info, _ := inj.Describe(NewKey("conn"))
+	s.Equal([]Key{NewKey("id")}, info.Dependencies)
+}
+
+func (s *SupplierTestSuite) TestSupplierSingleton() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("conn"), NewFactory[*supplierConn](func(_ Injector) (*supplierConn, error) {
+		return &supplierConn{ID: 1}, nil
+	}))
+	given := &struct {
+		Conn func() *supplierConn `inject:"conn"`
+	}{}
+
+	// -- When
+	//
+	err := inj.Inject(given)
+
+	// -- Then
+	//
+	if s.NoError(err) {
+		s.Same(given.Conn(), given.Conn())
+	}
+}
+
+func (s *SupplierTestSuite) TestSupplierError() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("conn"), NewFactory[*supplierConn](func(_ Injector) (*supplierConn, error) {
+		return nil, errors.New("refused")
+	}), WithScope(ScopeTransient))
+	given := &struct {
+		NewConn  func() (*supplierConn, error) `inject:"conn"`
+		MustConn func() *supplierConn          `inject:"conn"`
+	}{}
+	s.Require().NoError(inj.Inject(given))
+
+	// -- When
+	//
+	actual, err := given.NewConn()
+
+	// -- Then
+	//
+	s.Nil(actual)
+	s.ErrorContains(err, "refused")
+	s.Panics(func() {
+		given.MustConn()
+	})
+}
+
+func (s *SupplierTestSuite) TestSupplierFuncValue() {
+	// -- Given
+	//
+	inj := NewInjector()
+	inj.Add(NewKey("greet"), func() string {
+		return "hello"
+	})
+	inj.Add(newTypeKey[func() int](), func() int {
+		return 42
+	})
+	given := &struct {
+		Greet  func() string `inject:"greet"`
+		Answer func() int    `inject:",type"`
+	}{}
+
+	// -- When
+	//
+	err := inj.Inject(given)
+
+	// -- Then
+	//
+	if s.NoError(err) {
+		s.Equal("hello", given.Greet())
+		s.Equal(42, given.Answer())
+	}
+}
+
+func (s *SupplierTestSuite) TestSupplierNotSettable() {
+	// -- Given
+	//
+	inj, _ := s.newInjector()
+	given := &struct {
+		conn func() *supplierConn `inject:"conn"`
+	}{}
+
+	// -- When
+	//
+	err := inj.Inject(given)
+
+	// -- Then
+	//
+	s.ErrorIs(err, ErrInvalidField)
+}
+
+func (s *SupplierTestSuite) TestSupplierPrefilled() {
+	// -- Given
+	//
+	inj, builds := s.newInjector()
+	given := &struct {
+		Conn     func() *supplierConn `inject:"conn"`
+		Untagged func() *supplierConn `inject:","`
+	}{Conn: func() *supplierConn {
+		return &supplierConn{ID: -1}
+	}}
+
+	// -- When
+	//
+	err := inj.Inject(given)
+
+	// -- Then
+	//
+	if s.NoError(err) {
+		s.Equal(-1, given.Conn().ID)
+		s.Nil(given.Untagged)
+		s.Equal(0, *builds)
+	}
+}
+
+func (s *SupplierTestSuite) TestIsSupplierType() {
+	s.True(isSupplierType(reflect.TypeOf(func() int { return 0 })))
+	s.True(isSupplierType(reflect.TypeOf(func() (int, error) { return 0, nil })))
+	s.False(isSupplierType(reflect.TypeOf(func(int) int { return 0 })))
+	s.False(isSupplierType(reflect.TypeOf(func() (int, int) { return 0, 0 })))
+	s.False(isSupplierType(reflect.TypeOf(func() {})))
+	s.False(isSupplierType(reflect.TypeOf(1)))
+}
+
+func (s *SupplierTestSuite) TestTransientGet() {
+	// -- Given
+	//
+	inj, builds := s.newInjector()
+
+	// -- When
+	//
+	first, err := inj.Get(NewKey("conn"))
+	s.Require().NoError(err)
+	second, err := inj.Get(NewKey("conn"))
+	s.Require().NoError(err)
+
+	// -- Then
+	//
+	s.NotSame(first, second)
+	s.Equal(2, *builds)
+	info, _ := inj.Describe(NewKey("conn"))
+	s.Equal(ScopeTransient, info.Scope)
+	s.False(info.Instantiated)
+}
+
+func (s *SupplierTestSuite) TestTransientValue() {
+	// -- Given
+	//
+	inj := NewInjector()
+
+	// -- When
+	//
+	inj.Add(NewKey("port"), 8080, WithScope(ScopeTransient))
+
+	// -- Then
+	//
+	info, _ := inj.Describe(NewKey("port"))
+	s.Equal(ScopeSingleton, info.Scope)
+	actual, err := inj.Get(NewKey("port"))
+	if s.NoError(err) {
+		s.Equal(8080, actual)
+	}
+}
+
+func TestSupplierTestSuite(t *testing.T) {
+	suite.Run(t, new(SupplierTestSuite))
+}
@@ -0,0 +1,43 @@
+package axon
+
+import (
+	"github.com/eddieowens/axon/opts"
+)
+
+// Tracer traces the construction of values within the Injector. A Span is started whenever the Injector constructs a
+// value and is ended once the construction completes. Values that are constructed as part of constructing another value
+// (e.g. via Injector.Get within a Factory or via fields tagged with the InjectTag) are traced as children of that
+// value's Span.
+type Tracer interface {
+	// Start starts a Span for the construction of the value for key. parent is the Span of the value being constructed
+	// that depends on key or nil if nothing depends on key.
+	Start(parent Span, key Key) Span
+}
+
+// Span a single construction traced by a Tracer.
+type Span interface {
+	// End ends the Span. err is the error the construction failed with, if any.
+	End(err error)
+}
+
+// WithTracer traces the construction of all values within the Injector with t.
+func WithTracer(t Tracer) opts.Opt[InjectorOpts] {
+	return func(opts *InjectorOpts) {
+		opts.Tracer = t
+	}
+}
+
+type noopSpan struct{}
+
+func (n noopSpan) End(_ error) {}
+
+func (i *injector) startSpan(parent Span, key Key) Span {
+	if i.Tracer == nil {
+		return noopSpan{}
+	}
+
+	if _, ok := parent.(noopSpan); ok {
+		parent = nil
+	}
+	return i.Tracer.Start(parent, key)
+}
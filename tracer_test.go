@@ -0,0 +1,104 @@
+package axon
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type TracerTestSuite struct {
+	suite.Suite
+}
+
+func (t *TracerTestSuite) TestNestedSpans() {
+	// -- Given
+	//
+	tracer := new(recordingTracer)
+	inj := NewInjector(WithTracer(tracer))
+	inj.Add(NewKey("S"), "s")
+	inj.Add(NewKey("dep"), new(testDep))
+	inj.Add(NewKey("fact"), NewFactory[string](func(inj Injector) (string, error) {
+		_, err := inj.Get(NewKey("dep"))
+		return "fact", err
+	}))
+
+	// -- When
+	//
+	_, err := inj.Get(NewKey("fact"))
+	_, _ = inj.Get(NewKey("fact"))
+	_, _ = inj.Get(NewKey("S"))
+
+	// -- Then
+	//
+	if t.NoError(err) {
+		t.Equal([]string{
+			"start fact <nil>",
+			"start dep fact",
+			"start S dep",
+			"end S <nil>",
+			"end dep <nil>",
+			"end fact <nil>",
+		}, tracer.Events)
+	}
+}
+
+func (t *TracerTestSuite) TestSpanErr() {
+	// -- Given
+	//
+	tracer := new(recordingTracer)
+	inj := NewInjector(WithTracer(tracer))
+	inj.Add(NewKey("fact"), NewFactory[string](func(inj Injector) (string, error) {
+		return "", errors.New("error")
+	}))
+
+	// -- When
+	//
+	_, _ = inj.Get(NewKey("fact"))
+
+	// -- Then
+	//
+	t.Equal([]string{"start fact <nil>", "end fact error"}, tracer.Events)
+}
+
+func (t *TracerTestSuite) TestNoopParent() {
+	// -- Given
+	//
+	tracer := new(recordingTracer)
+	inj := &injector{Tracer: tracer}
+
+	// -- When
+	//
+	span := inj.startSpan(noopSpan{}, NewKey("key"))
+	span.End(nil)
+
+	// -- Then
+	//
+	t.Equal([]string{"start key <nil>", "end key <nil>"}, tracer.Events)
+}
+
+type recordingTracer struct {
+	Events []string
+}
+
+func (r *recordingTracer) Start(parent Span, key Key) Span {
+	var p any
+	if parent != nil {
+		p = parent.(*recordingSpan).Key
+	}
+	r.Events = append(r.Events, "start "+key.String()+" "+fmt.Sprint(p))
+	return &recordingSpan{Key: key, Tracer: r}
+}
+
+type recordingSpan struct {
+	Key    Key
+	Tracer *recordingTracer
+}
+
+func (r *recordingSpan) End(err error) {
+	r.Tracer.Events = append(r.Tracer.Events, "end "+r.Key.String()+" "+fmt.Sprint(err))
+}
+
+func TestTracerTestSuite(t *testing.T) {
+	suite.Run(t, new(TracerTestSuite))
+}